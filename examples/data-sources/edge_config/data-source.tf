@@ -0,0 +1,7 @@
+data "edge_config" "interfaces" {
+  path = ["interfaces", "ethernet"]
+}
+
+output "ethernet_interfaces" {
+  value = keys(jsondecode(data.edge_config.interfaces.json))
+}
@@ -0,0 +1,15 @@
+resource "edge_bgp_neighbor" "upstream" {
+  asn         = edge_protocols_bgp.example.asn
+  address     = "203.0.113.1"
+  remote_as   = 64500
+  description = "transit"
+  password    = var.bgp_password
+
+  prefix_list = {
+    export = edge_prefix_list.announce.name
+  }
+
+  route_map = {
+    import = edge_route_map.upstream.name
+  }
+}
@@ -0,0 +1,20 @@
+# A valueless node.
+resource "edge_config_node" "lldp" {
+  path = ["service", "lldp", "interface", "all"]
+}
+
+# A leaf.
+resource "edge_config_node" "lldp_address" {
+  path  = ["service", "lldp", "management-address"]
+  value = "192.168.1.1"
+}
+
+# A subtree. All values are strings and valueless nodes are null.
+resource "edge_config_node" "telnet" {
+  path = ["service", "telnet"]
+  json = jsonencode({
+    "port"           = "2323"
+    "listen-address" = "192.168.1.1"
+    "allow-root"     = null
+  })
+}
@@ -0,0 +1,10 @@
+resource "edge_firewall_address_group" "servers" {
+  name                      = "servers"
+  description               = "hosts registered by the application teams"
+  ignore_undeclared_members = true
+}
+
+resource "edge_firewall_address_group_member" "example" {
+  group = edge_firewall_address_group.servers.name
+  cidr  = "192.168.2.10/32"
+}
@@ -0,0 +1,17 @@
+resource "edge_firewall_global_options" "example" {
+  all_ping               = true
+  broadcast_ping         = false
+  ip_src_route           = false
+  log_martians           = true
+  receive_redirects      = false
+  send_redirects         = false
+  source_validation      = "strict"
+  syn_cookies            = true
+  ipv6_receive_redirects = false
+  ipv6_src_route         = false
+
+  mss_clamp = {
+    interface_types = ["pppoe"]
+    mss             = 1452
+  }
+}
@@ -0,0 +1,26 @@
+resource "edge_firewall_modify_ruleset" "example" {
+  name        = "example"
+  description = "route the guest network through the second WAN"
+
+  rule {
+    priority    = 10
+    description = "guest network"
+    action      = "modify"
+
+    source = {
+      address = "192.168.10.0/24"
+    }
+
+    modify = {
+      table = edge_static_route_table.example.id
+    }
+  }
+}
+
+resource "edge_firewall_ruleset_attachment" "example" {
+  interface = "eth2"
+
+  modify = {
+    in = edge_firewall_modify_ruleset.example.name
+  }
+}
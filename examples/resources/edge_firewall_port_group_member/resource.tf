@@ -0,0 +1,16 @@
+resource "edge_firewall_port_group" "services" {
+  name                      = "services"
+  description               = "ports registered by the application teams"
+  ignore_undeclared_members = true
+}
+
+resource "edge_firewall_port_group_member" "https" {
+  group = edge_firewall_port_group.services.name
+  from  = 443
+}
+
+resource "edge_firewall_port_group_member" "example" {
+  group = edge_firewall_port_group.services.name
+  from  = 8000
+  to    = 8100
+}
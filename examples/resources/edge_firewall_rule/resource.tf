@@ -0,0 +1,30 @@
+resource "edge_firewall_ruleset" "wan_local" {
+  name                    = "WAN_LOCAL"
+  default_action          = "drop"
+  ignore_undeclared_rules = true
+
+  rule {
+    priority = 10
+    action   = "accept"
+
+    state = {
+      established = true
+      related     = true
+    }
+  }
+}
+
+resource "edge_firewall_rule" "example" {
+  ruleset     = edge_firewall_ruleset.wan_local.name
+  priority    = 20
+  description = "wireguard"
+  action      = "accept"
+  protocol    = "udp"
+
+  destination = {
+    port = {
+      from = 51820
+      to   = 51820
+    }
+  }
+}
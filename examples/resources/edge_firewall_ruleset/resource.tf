@@ -19,4 +19,59 @@ resource "edge_firewall_ruleset" "example" {
       }
     }
   }
+
+  rule {
+    priority    = 20
+    description = "ssh brute force"
+    action      = "drop"
+    protocol    = "tcp"
+
+    destination = {
+      port = {
+        from = 22
+        to   = 22
+      }
+    }
+
+    state = {
+      new = true
+    }
+
+    recent = {
+      count = 4
+      time  = 60
+    }
+  }
+
+  rule {
+    priority    = 30
+    description = "ping"
+    action      = "accept"
+    protocol    = "icmp"
+
+    icmp = {
+      type_name = "echo-request"
+    }
+
+    limit = {
+      rate  = "10/second"
+      burst = 20
+    }
+  }
+
+  rule {
+    priority    = 40
+    description = "no internet for the kids at night"
+    action      = "drop"
+
+    source = {
+      address_group = "kids"
+    }
+
+    time = {
+      start_time = "21:00:00"
+      stop_time  = "07:00:00"
+      weekdays   = "Sun,Mon,Tue,Wed,Thu"
+    }
+  }
 }
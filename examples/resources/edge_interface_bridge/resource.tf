@@ -0,0 +1,7 @@
+resource "edge_interface_bridge" "example" {
+  name        = "br0"
+  description = "lan"
+  addresses   = ["192.168.1.1/24"]
+  members     = ["eth1", "eth2"]
+  stp         = true
+}
@@ -0,0 +1,20 @@
+resource "edge_interface_switch" "example" {
+  name       = "switch0"
+  addresses  = ["192.168.1.1/24"]
+  vlan_aware = true
+
+  ports = {
+    eth2 = {
+      pvid = 1
+      vids = [20]
+    }
+    eth3 = {}
+  }
+
+  vifs = {
+    "20" = {
+      description = "guest"
+      addresses   = ["192.168.20.1/24"]
+    }
+  }
+}
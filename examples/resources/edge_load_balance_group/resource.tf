@@ -0,0 +1,27 @@
+resource "edge_load_balance_group" "example" {
+  name = "wan"
+
+  interfaces = {
+    eth0 = {
+      weight = 80
+      route_test = {
+        type     = "ping"
+        target   = "1.1.1.1"
+        interval = 10
+      }
+    }
+    eth1 = {
+      failover_only = true
+      route_test = {
+        type = "default"
+      }
+    }
+  }
+
+  sticky = {
+    source_address = true
+  }
+
+  lb_local        = true
+  flush_on_active = true
+}
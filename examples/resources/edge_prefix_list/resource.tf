@@ -0,0 +1,10 @@
+resource "edge_prefix_list" "announce" {
+  name = "announce"
+
+  rules = {
+    "10" = {
+      action = "permit"
+      prefix = "198.51.100.0/24"
+    }
+  }
+}
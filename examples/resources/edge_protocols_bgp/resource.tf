@@ -0,0 +1,5 @@
+resource "edge_protocols_bgp" "example" {
+  asn       = 65000
+  router_id = "10.0.0.1"
+  networks  = ["198.51.100.0/24"]
+}
@@ -0,0 +1,11 @@
+resource "edge_protocols_igmp_proxy" "example" {
+  interfaces = {
+    eth0 = {
+      role        = "upstream"
+      alt_subnets = ["0.0.0.0/0"]
+    }
+    eth1 = {
+      role = "downstream"
+    }
+  }
+}
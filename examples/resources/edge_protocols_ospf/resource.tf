@@ -0,0 +1,17 @@
+resource "edge_protocols_ospf" "example" {
+  areas = {
+    "0" = {
+      networks = ["10.0.0.0/24", "10.0.1.0/24"]
+    }
+  }
+
+  passive_interfaces = ["eth1"]
+
+  redistribute = {
+    connected = {}
+  }
+
+  parameters = {
+    router_id = "10.0.0.1"
+  }
+}
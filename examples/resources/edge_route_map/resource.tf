@@ -0,0 +1,12 @@
+resource "edge_route_map" "upstream" {
+  name = "upstream-in"
+
+  rules = {
+    "10" = {
+      action = "permit"
+      set = {
+        local_preference = 200
+      }
+    }
+  }
+}
@@ -0,0 +1,9 @@
+resource "edge_service_dns_dynamic" "example" {
+  interface  = "eth0"
+  service    = "custom-cloudflare"
+  protocol   = "cloudflare"
+  server     = "api.cloudflare.com/client/v4"
+  host_names = ["branch.example.com"]
+  login      = "admin@example.com"
+  password   = var.cloudflare_api_key
+}
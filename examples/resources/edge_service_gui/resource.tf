@@ -0,0 +1,6 @@
+resource "edge_service_gui" "example" {
+  http_port      = 80
+  https_port     = 443
+  listen_address = "192.168.1.1"
+  older_ciphers  = false
+}
@@ -0,0 +1,3 @@
+resource "edge_service_mdns_repeater" "example" {
+  interfaces = ["eth1", "eth2"]
+}
@@ -0,0 +1,22 @@
+resource "edge_service_snmp" "example" {
+  contact          = "noc@example.com"
+  location         = "rack 1"
+  listen_addresses = ["192.168.1.1"]
+
+  communities = {
+    "monitoring" = {
+      authorization = "ro"
+      networks      = ["192.168.1.0/24"]
+    }
+  }
+
+  v3_users = {
+    "monitoring" = {
+      mode         = "ro"
+      auth_type    = "sha"
+      auth_key     = var.snmp_auth_key
+      privacy_type = "aes"
+      privacy_key  = var.snmp_privacy_key
+    }
+  }
+}
@@ -0,0 +1,6 @@
+resource "edge_service_ssh" "example" {
+  port                            = 22
+  listen_addresses                = ["192.168.1.1"]
+  protocol_version                = "v2"
+  disable_password_authentication = true
+}
@@ -0,0 +1,19 @@
+resource "edge_service_upnp2" "example" {
+  wan_interface = "eth0"
+  listen_on     = ["eth1"]
+  nat_pmp       = true
+  secure_mode   = true
+
+  acl = {
+    "10" = {
+      action        = "allow"
+      subnet        = "192.168.1.0/24"
+      local_port    = "1024-65535"
+      external_port = "1024-65535"
+    }
+    "20" = {
+      action = "deny"
+      subnet = "0.0.0.0/0"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+resource "edge_static_route_table" "example" {
+  table       = 10
+  description = "route through the second WAN"
+
+  routes = {
+    "0.0.0.0/0" = {
+      next_hops = ["203.0.113.1"]
+    }
+  }
+}
@@ -0,0 +1,19 @@
+resource "edge_system" "example" {
+  host_name    = "gateway"
+  domain_name  = "example.com"
+  time_zone    = "America/Los_Angeles"
+  name_servers = ["1.1.1.1", "8.8.8.8"]
+
+  ntp_servers = [
+    "0.pool.ntp.org",
+    "1.pool.ntp.org",
+  ]
+
+  offload = {
+    ipv4 = {
+      forwarding = true
+      vlan       = true
+    }
+    ipsec = true
+  }
+}
@@ -0,0 +1,20 @@
+resource "edge_system_conntrack" "example" {
+  table_size        = 262144
+  expect_table_size = 2048
+  hash_size         = 32768
+
+  timeouts = {
+    tcp = {
+      established = 7440
+    }
+    udp = {
+      other  = 30
+      stream = 180
+    }
+  }
+
+  modules = {
+    sip  = false
+    h323 = false
+  }
+}
@@ -0,0 +1,6 @@
+resource "edge_system_syslog_host" "example" {
+  host     = "192.168.1.20"
+  port     = 5514
+  facility = "all"
+  level    = "notice"
+}
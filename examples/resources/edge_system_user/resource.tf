@@ -0,0 +1,6 @@
+resource "edge_system_user" "example" {
+  name      = "alice"
+  full_name = "Alice Example"
+  level     = "admin"
+  password  = var.alice_password
+}
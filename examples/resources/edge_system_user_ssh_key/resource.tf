@@ -0,0 +1,6 @@
+resource "edge_system_user_ssh_key" "example" {
+  user = edge_system_user.example.name
+  name = "alice@laptop"
+  type = "ssh-ed25519"
+  key  = "AAAAC3NzaC1lZDI1NTE5AAAAIGdZPrN7Xs4GsG5Qi9I8gIzGkO1m0QuYy2rX0lEo2zJx"
+}
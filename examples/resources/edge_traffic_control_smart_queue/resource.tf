@@ -0,0 +1,14 @@
+resource "edge_traffic_control_smart_queue" "example" {
+  name          = "wan"
+  wan_interface = "eth0"
+
+  download = {
+    rate = "95mbit"
+    ecn  = true
+  }
+
+  upload = {
+    rate   = "19mbit"
+    target = "5ms"
+  }
+}
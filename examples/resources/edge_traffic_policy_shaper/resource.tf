@@ -0,0 +1,27 @@
+resource "edge_traffic_policy_shaper" "example" {
+  name      = "wan-out"
+  bandwidth = "20mbit"
+
+  default = {
+    bandwidth = "50%"
+    ceiling   = "100%"
+  }
+
+  classes = {
+    "10" = {
+      description = "voip"
+      bandwidth   = "2mbit"
+      priority    = 0
+      queue_type  = "fq-codel"
+
+      match = {
+        phones = {
+          protocol = "udp"
+          source = {
+            address_group = "phones"
+          }
+        }
+      }
+    }
+  }
+}
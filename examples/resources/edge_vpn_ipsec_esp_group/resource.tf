@@ -0,0 +1,11 @@
+resource "edge_vpn_ipsec_esp_group" "example" {
+  name     = "example"
+  lifetime = 3600
+  mode     = "tunnel"
+  pfs      = "dh-group14"
+
+  proposal = [{
+    encryption = "aes256"
+    hash       = "sha256"
+  }]
+}
@@ -0,0 +1,17 @@
+resource "edge_vpn_ipsec_ike_group" "example" {
+  name         = "example"
+  key_exchange = "ikev2"
+  lifetime     = 28800
+
+  dead_peer_detection = {
+    action   = "restart"
+    interval = 30
+    timeout  = 120
+  }
+
+  proposal = [{
+    encryption = "aes256"
+    hash       = "sha256"
+    dh_group   = 14
+  }]
+}
@@ -0,0 +1,20 @@
+resource "edge_vpn_ipsec_site_to_site_peer" "example" {
+  peer              = "203.0.113.10"
+  description       = "example peer"
+  pre_shared_secret = var.pre_shared_secret
+  local_address     = "any"
+  ike_group         = edge_vpn_ipsec_ike_group.example.name
+  default_esp_group = edge_vpn_ipsec_esp_group.example.name
+
+  tunnel = {
+    "1" = {
+      local_prefix  = "192.168.1.0/24"
+      remote_prefix = "10.0.0.0/24"
+    }
+  }
+
+  local_firewall = {
+    ruleset  = "WAN_LOCAL"
+    priority = 100
+  }
+}
@@ -0,0 +1,17 @@
+resource "edge_vpn_l2tp_remote_access" "example" {
+  outside_address   = "203.0.113.1"
+  pre_shared_secret = var.pre_shared_secret
+  dns_servers       = ["192.168.1.1"]
+  mtu               = 1492
+
+  client_ip_pool = {
+    start = "192.168.100.10"
+    stop  = "192.168.100.50"
+  }
+
+  local_users = {
+    "alice" = {
+      password = var.alice_password
+    }
+  }
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+)
+
+const (
+	tokenKey = "X-CSRF-TOKEN"
+)
+
+// Client reads and writes arbitrary nodes of the EdgeOS configuration tree.
+//
+// Nodes are addressed by their path, e.g.
+// []string{"vpn", "ipsec", "ike-group", "FOO"}, and (un)marshalled with
+// encoding/json.
+type Client interface {
+	// Get unmarshals the node at path into v. If the node does not exist,
+	// a *NotFoundError is returned.
+	Get(ctx context.Context, v interface{}, path ...string) error
+	// Set merges v into the node at path.
+	Set(ctx context.Context, v interface{}, path ...string) error
+	// Update transforms the node at path from current into desired.
+	Update(ctx context.Context, current, desired interface{}, path ...string) error
+	// Delete removes the node at path.
+	Delete(ctx context.Context, path ...string) error
+	// Post sends a batch of operations which is committed and saved atomically.
+	Post(ctx context.Context, op *Operation) error
+}
+
+type client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// Login authenticates against the EdgeOS web interface. The returned http.Client
+// holds the session cookies.
+func Login(host string, insecure bool, username, password string) (*http.Client, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: insecure,
+			},
+		},
+		Jar: jar,
+	}
+
+	form := url.Values{}
+	form.Set("username", username)
+	form.Set("password", password)
+
+	req, err := http.NewRequest(http.MethodPost, host, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("Could not log in to %s: %s", host, resp.Status)
+	}
+
+	// The router answers a failed login with the login page again, so the
+	// only sign of success is the CSRF token that every later request needs.
+	if !hasCookie(jar, resp.Request.URL, tokenKey) {
+		return nil, fmt.Errorf("Could not log in to %s: the username or password is incorrect.", host)
+	}
+
+	return httpClient, nil
+}
+
+func hasCookie(jar http.CookieJar, u *url.URL, name string) bool {
+	for _, cookie := range jar.Cookies(u) {
+		if cookie.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func New(httpClient *http.Client, baseURL string) Client {
+	return &client{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+	}
+}
+
+func (c *client) Get(ctx context.Context, v interface{}, path ...string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/edge/get.json", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Success bool        `json:"success"`
+		Get     interface{} `json:"GET"`
+	}
+	if err := decode(resp.Body, &out); err != nil {
+		return err
+	}
+	if !out.Success {
+		return fmt.Errorf("Could not retrieve the configuration.")
+	}
+
+	node, ok := Lookup(out.Get, path...)
+	if !ok {
+		return &NotFoundError{Path: path}
+	}
+
+	data, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (c *client) Set(ctx context.Context, v interface{}, path ...string) error {
+	op, err := NewSet(v, path...)
+	if err != nil {
+		return err
+	}
+	return c.Post(ctx, op)
+}
+
+func (c *client) Update(ctx context.Context, current, desired interface{}, path ...string) error {
+	op, err := NewUpdate(current, desired, path...)
+	if err != nil {
+		return err
+	}
+	if op.IsEmpty() {
+		return nil
+	}
+	return c.Post(ctx, op)
+}
+
+func (c *client) Delete(ctx context.Context, path ...string) error {
+	return c.Post(ctx, NewDelete(path...))
+}
+
+func (c *client) Post(ctx context.Context, op *Operation) error {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/edge/batch.json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+
+	for _, cookie := range c.httpClient.Jar.Cookies(req.URL) {
+		if cookie.Name == tokenKey {
+			req.Header.Set(tokenKey, cookie.Value)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var out result
+	if err := decode(resp.Body, &out); err != nil {
+		return err
+	}
+	return out.err()
+}
+
+func decode(reader io.Reader, v interface{}) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("Could not unmarshal operation from data %s: %s", string(data), err.Error())
+	}
+	return nil
+}
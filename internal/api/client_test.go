@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// router fakes the parts of the EdgeOS web interface the client uses.
+func router(t *testing.T, password, tree string, posted *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			if err := r.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			if r.Form.Get("password") == password {
+				http.SetCookie(w, &http.Cookie{Name: tokenKey, Value: "token"})
+			}
+			w.Write([]byte("<html></html>"))
+		case "/api/edge/get.json":
+			w.Write([]byte(`{"success":true,"GET":` + tree + `}`))
+		case "/api/edge/batch.json":
+			if r.Header.Get(tokenKey) != "token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			data, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			*posted = string(data)
+			w.Write([]byte(`{"success":true,"SET":{"failure":"0"},"COMMIT":{"failure":"0"},"SAVE":{"success":"1"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestLogin(t *testing.T) {
+	server := router(t, "ubnt", `{}`, nil)
+	defer server.Close()
+
+	if _, err := Login(server.URL+"/", false, "ubnt", "ubnt"); err != nil {
+		t.Errorf("expected the login to succeed, got %v", err)
+	}
+
+	if _, err := Login(server.URL+"/", false, "ubnt", "wrong"); err == nil || !strings.Contains(err.Error(), "the username or password is incorrect") {
+		t.Errorf("expected the login to fail because of the password, got %v", err)
+	}
+
+	if _, err := Login(server.URL+"/missing", false, "ubnt", "ubnt"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected the login to fail because of the status, got %v", err)
+	}
+}
+
+func TestClient(t *testing.T) {
+	var posted string
+	server := router(t, "ubnt", `{"service":{"ssh":{"port":"22"}}}`, &posted)
+	defer server.Close()
+
+	httpClient, err := Login(server.URL+"/", false, "ubnt", "ubnt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := New(httpClient, server.URL)
+	ctx := context.Background()
+
+	var ssh struct {
+		Port string `json:"port"`
+	}
+	if err := c.Get(ctx, &ssh, "service", "ssh"); err != nil {
+		t.Fatal(err)
+	}
+	if ssh.Port != "22" {
+		t.Errorf("expected port 22, got %s", ssh.Port)
+	}
+
+	if err := c.Get(ctx, &ssh, "service", "telnet"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if err := c.Set(ctx, map[string]string{"port": "2222"}, "service", "ssh"); err != nil {
+		t.Fatal(err)
+	}
+	var op Operation
+	if err := json.Unmarshal([]byte(posted), &op); err != nil {
+		t.Fatal(err)
+	}
+	if port, _ := Lookup(op.Set, "service", "ssh", "port"); port != "2222" {
+		t.Errorf("expected the port to be set, got %s", posted)
+	}
+}
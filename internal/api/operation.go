@@ -0,0 +1,177 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Operation is a batch of changes to the configuration. The router commits and
+// saves the whole batch or none of it.
+type Operation struct {
+	Set    map[string]interface{} `json:"SET,omitempty"`
+	Delete map[string]interface{} `json:"DELETE,omitempty"`
+}
+
+// NewSet returns an operation that merges v into the node at path.
+func NewSet(v interface{}, path ...string) (*Operation, error) {
+	tree, err := Tree(v)
+	if err != nil {
+		return nil, err
+	}
+	return &Operation{
+		Set: Nest(tree, path...),
+	}, nil
+}
+
+// NewDelete returns an operation that removes the node at path.
+func NewDelete(path ...string) *Operation {
+	return &Operation{
+		Delete: Nest(nil, path...),
+	}
+}
+
+// NewUpdate returns an operation that transforms the node at path from
+// current into desired. Everything in desired is set and everything that is in
+// current but not in desired is deleted.
+func NewUpdate(current, desired interface{}, path ...string) (*Operation, error) {
+	c, err := Tree(current)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := Tree(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	op := new(Operation)
+	if !isEmpty(d) && !equal(c, d) {
+		op.Set = Nest(d, path...)
+	}
+	if stale, ok := Diff(c, d); ok {
+		op.Delete = Nest(stale, path...)
+	}
+	return op, nil
+}
+
+// NewLeafUpdate is like NewUpdate but only ever deletes the leaves of current.
+// It is meant for nodes of which only some children are modelled, e.g.
+// `system`, so that the others are left alone.
+func NewLeafUpdate(current, desired interface{}, path ...string) (*Operation, error) {
+	op, err := NewUpdate(current, desired, path...)
+	if err != nil || op.Delete == nil {
+		return op, err
+	}
+
+	c, err := Tree(current)
+	if err != nil {
+		return nil, err
+	}
+	stale, _ := Lookup(op.Delete, path...)
+	op.Delete = Nest(Leaves(stale, c), path...)
+	return op, nil
+}
+
+// Merge folds other into op and returns op.
+func (op *Operation) Merge(other *Operation) *Operation {
+	if other == nil {
+		return op
+	}
+	if other.Set != nil {
+		if op.Set == nil {
+			op.Set = map[string]interface{}{}
+		}
+		merge(op.Set, other.Set)
+	}
+	if other.Delete != nil {
+		if op.Delete == nil {
+			op.Delete = map[string]interface{}{}
+		}
+		merge(op.Delete, other.Delete)
+	}
+	return op
+}
+
+// IsEmpty reports whether sending op would be a no-op.
+func (op *Operation) IsEmpty() bool {
+	return op == nil || (len(op.Set) == 0 && len(op.Delete) == 0)
+}
+
+// NotFoundError is returned when a node does not exist in the configuration.
+type NotFoundError struct {
+	Path []string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("The configuration node `%s` does not exist.", strings.Join(e.Path, " "))
+}
+
+// IsNotFound reports whether err means the requested node does not exist.
+func IsNotFound(err error) bool {
+	var e *NotFoundError
+	return errors.As(err, &e)
+}
+
+type result struct {
+	Success bool    `json:"success"`
+	Set     *status `json:"SET,omitempty"`
+	Delete  *status `json:"DELETE,omitempty"`
+	Commit  *status `json:"COMMIT,omitempty"`
+	Save    *status `json:"SAVE,omitempty"`
+}
+
+type status struct {
+	Failure string          `json:"failure"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+func (r result) err() error {
+	msgs := []string{}
+	failed := !r.Success
+
+	for _, s := range []*status{r.Set, r.Delete, r.Commit, r.Save} {
+		if s == nil || s.Failure != "1" {
+			continue
+		}
+		failed = true
+		msgs = append(msgs, s.messages()...)
+	}
+
+	if !failed {
+		return nil
+	}
+
+	if len(msgs) == 0 {
+		msgs = append(msgs, "The operation failed for a unknown reason.")
+	}
+
+	return errors.New(strings.Join(msgs, ", "))
+}
+
+// messages flattens the error of a status. The router reports either a single
+// string or an object keyed by the offending configuration path.
+func (s *status) messages() []string {
+	if len(s.Error) == 0 {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(s.Error, &str); err == nil {
+		return []string{strings.TrimSpace(str)}
+	}
+
+	var byPath map[string]string
+	if err := json.Unmarshal(s.Error, &byPath); err == nil {
+		msgs := []string{}
+		for path, msg := range byPath {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", path, strings.TrimSpace(msg)))
+		}
+		sort.Strings(msgs)
+		return msgs
+	}
+
+	return []string{string(s.Error)}
+}
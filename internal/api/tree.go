@@ -0,0 +1,170 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Tree converts v into the generic representation of a configuration node:
+// maps for nodes with children, slices for multi-value nodes, strings for
+// values and nil for valueless nodes.
+func Tree(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// Lookup returns the node at path.
+func Lookup(tree interface{}, path ...string) (interface{}, bool) {
+	node := tree
+	for _, step := range path {
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if node, ok = m[step]; !ok {
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// Nest places v at path. An empty v is treated as a valueless node.
+func Nest(v interface{}, path ...string) map[string]interface{} {
+	if isEmpty(v) {
+		v = nil
+	}
+
+	if len(path) == 0 {
+		m, _ := v.(map[string]interface{})
+		return m
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		v = map[string]interface{}{path[i]: v}
+	}
+	return v.(map[string]interface{})
+}
+
+// Diff returns the parts of current that must be deleted so that setting
+// desired on top of current yields desired. The boolean is false if nothing
+// needs to be deleted. A nil node with a true boolean means the whole node must
+// be deleted.
+func Diff(current, desired interface{}) (interface{}, bool) {
+	switch c := current.(type) {
+	case map[string]interface{}:
+		d, ok := desired.(map[string]interface{})
+		if !ok {
+			return nil, true
+		}
+
+		stale := map[string]interface{}{}
+		for k, cv := range c {
+			dv, ok := d[k]
+			if !ok {
+				stale[k] = nil
+				continue
+			}
+			if s, ok := Diff(cv, dv); ok {
+				stale[k] = s
+			}
+		}
+
+		if len(stale) == 0 {
+			return nil, false
+		}
+		return stale, true
+	case []interface{}:
+		d, ok := desired.([]interface{})
+		if !ok {
+			return nil, true
+		}
+
+		stale := []interface{}{}
+		for _, cv := range c {
+			if !contains(d, cv) {
+				stale = append(stale, cv)
+			}
+		}
+
+		if len(stale) == 0 {
+			return nil, false
+		}
+		return stale, true
+	case nil:
+		return nil, false
+	default:
+		switch desired.(type) {
+		case map[string]interface{}, []interface{}, nil:
+			return nil, true
+		}
+		return nil, false
+	}
+}
+
+// Leaves expands the nodes that Diff marks for deletion as a whole into the
+// leaves they have in current. Deleting those leaves keeps any children of the
+// node that current does not know about.
+func Leaves(stale, current interface{}) interface{} {
+	c, ok := current.(map[string]interface{})
+	if !ok {
+		return stale
+	}
+
+	switch s := stale.(type) {
+	case nil:
+		if len(c) == 0 {
+			return nil
+		}
+		leaves := map[string]interface{}{}
+		for k, cv := range c {
+			leaves[k] = Leaves(nil, cv)
+		}
+		return leaves
+	case map[string]interface{}:
+		leaves := map[string]interface{}{}
+		for k, sv := range s {
+			leaves[k] = Leaves(sv, c[k])
+		}
+		return leaves
+	default:
+		return stale
+	}
+}
+
+func merge(dst, src map[string]interface{}) {
+	for k, sv := range src {
+		dm, dok := dst[k].(map[string]interface{})
+		sm, sok := sv.(map[string]interface{})
+		if dok && sok {
+			merge(dm, sm)
+			continue
+		}
+		dst[k] = sv
+	}
+}
+
+func contains(list []interface{}, v interface{}) bool {
+	for _, elem := range list {
+		if equal(elem, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func equal(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func isEmpty(v interface{}) bool {
+	m, ok := v.(map[string]interface{})
+	return ok && len(m) == 0
+}
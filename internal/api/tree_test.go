@@ -0,0 +1,155 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		current  string
+		desired  string
+		expected string
+		ok       bool
+	}{
+		{
+			name:    "identical trees",
+			current: `{"lifetime": "3600", "proposal": {"1": {"hash": "sha1"}}}`,
+			desired: `{"lifetime": "3600", "proposal": {"1": {"hash": "sha1"}}}`,
+		},
+		{
+			name:    "changed values are only set",
+			current: `{"lifetime": "3600"}`,
+			desired: `{"lifetime": "28800"}`,
+		},
+		{
+			name:     "removed children are deleted",
+			current:  `{"lifetime": "3600", "proposal": {"1": {"hash": "sha1"}, "2": {"hash": "md5"}}}`,
+			desired:  `{"proposal": {"1": {"hash": "sha1"}}}`,
+			expected: `{"lifetime": null, "proposal": {"2": null}}`,
+			ok:       true,
+		},
+		{
+			name:     "removed values of a multi-value node are deleted",
+			current:  `{"address": ["10.0.0.1", "10.0.0.2", "10.0.0.3"]}`,
+			desired:  `{"address": ["10.0.0.2", "10.0.0.4"]}`,
+			expected: `{"address": ["10.0.0.1", "10.0.0.3"]}`,
+			ok:       true,
+		},
+		{
+			name:     "valueless nodes are deleted",
+			current:  `{"enable-default-log": null, "description": "foo"}`,
+			desired:  `{"description": "foo"}`,
+			expected: `{"enable-default-log": null}`,
+			ok:       true,
+		},
+		{
+			name:     "empty desired state deletes everything",
+			current:  `{"description": "foo"}`,
+			desired:  `{}`,
+			expected: `{"description": null}`,
+			ok:       true,
+		},
+	} {
+		stale, ok := Diff(unmarshal(t, test.current), unmarshal(t, test.desired))
+		if ok != test.ok {
+			t.Fatalf("%s: expected ok to be %v but got %v", test.name, test.ok, ok)
+		}
+		if !ok {
+			continue
+		}
+		if expected := unmarshal(t, test.expected); !reflect.DeepEqual(expected, stale) {
+			t.Fatalf("%s: expected %v but got %v", test.name, expected, stale)
+		}
+	}
+}
+
+func TestNewUpdate(t *testing.T) {
+	op, err := NewUpdate(
+		unmarshal(t, `{"lifetime": "3600", "mode": "tunnel"}`),
+		unmarshal(t, `{"lifetime": "28800"}`),
+		"vpn", "ipsec", "esp-group", "FOO",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"SET":{"vpn":{"ipsec":{"esp-group":{"FOO":{"lifetime":"28800"}}}}},"DELETE":{"vpn":{"ipsec":{"esp-group":{"FOO":{"mode":null}}}}}}`
+	if string(data) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(data))
+	}
+}
+
+func TestLeaves(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		stale    string
+		current  string
+		expected string
+	}{
+		{
+			name:     "leaves are kept",
+			stale:    `{"host-name": null, "name-server": ["10.0.0.1"]}`,
+			current:  `{"host-name": "ubnt", "name-server": ["10.0.0.1", "10.0.0.2"]}`,
+			expected: `{"host-name": null, "name-server": ["10.0.0.1"]}`,
+		},
+		{
+			name:     "nodes are expanded",
+			stale:    `{"offload": null}`,
+			current:  `{"offload": {"ipv4": {"forwarding": "enable"}, "ipsec": "enable"}}`,
+			expected: `{"offload": {"ipv4": {"forwarding": null}, "ipsec": null}}`,
+		},
+		{
+			name:     "nested nodes are expanded",
+			stale:    `{"offload": {"ipv4": null}}`,
+			current:  `{"offload": {"ipv4": {"forwarding": "enable", "vlan": "enable"}, "ipsec": "enable"}}`,
+			expected: `{"offload": {"ipv4": {"forwarding": null, "vlan": null}}}`,
+		},
+		{
+			name:     "valueless leaves are deleted",
+			stale:    `{"ntp": null}`,
+			current:  `{"ntp": {"server": {"0.ubnt.pool.ntp.org": null}}}`,
+			expected: `{"ntp": {"server": {"0.ubnt.pool.ntp.org": null}}}`,
+		},
+	} {
+		if actual, expected := Leaves(unmarshal(t, test.stale), unmarshal(t, test.current)), unmarshal(t, test.expected); !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: expected %v but got %v", test.name, expected, actual)
+		}
+	}
+}
+
+func TestNewLeafUpdate(t *testing.T) {
+	op, err := NewLeafUpdate(
+		unmarshal(t, `{"host-name": "router", "offload": {"ipv4": {"forwarding": "enable"}}}`),
+		unmarshal(t, `{"host-name": "router"}`),
+		"system",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"SET":{"system":{"host-name":"router"}},"DELETE":{"system":{"offload":{"ipv4":{"forwarding":null}}}}}`
+	if string(data) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(data))
+	}
+}
+
+func unmarshal(t *testing.T, data string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
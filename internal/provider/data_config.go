@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type dataSourceConfigType struct{}
+
+func (r dataSourceConfigType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "A subtree of the configuration as JSON, to be decoded with `jsondecode`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The path separated by spaces, or the path as a JSON array if an element contains whitespace.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"path": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The path of the subtree, e.g. `[\"service\", \"lldp\"]`.",
+				Validators: []tfsdk.AttributeValidator{
+					minItems(1),
+				},
+			},
+			"json": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The subtree as JSON. All values are strings and valueless nodes are `null`.",
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceConfigType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceConfig{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceConfig struct {
+	p provider
+}
+
+func (r dataSourceConfig) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"The provider has not been configured!",
+			"Please configure the provider.",
+		)
+		return
+	}
+
+	var path []string
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("path"), &path)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := configNodeID(path)
+
+	var tree interface{}
+	if err := r.p.config.Get(ctx, &tree, path...); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("There was an issue retrieving the config %s.", id),
+			err.Error(),
+		)
+		return
+	}
+
+	data, err := json.Marshal(tree)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("There was an issue retrieving the config %s.", id),
+			err.Error(),
+		)
+		return
+	}
+
+	for attribute, value := range map[string]interface{}{
+		"id":   id,
+		"path": path,
+		"json": string(data),
+	} {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName(attribute), value)...)
+	}
+}
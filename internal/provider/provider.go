@@ -6,7 +6,7 @@ import (
 	"os"
 	"strings"
 
-	"github.com/frankgreco/edge-sdk-go"
+	"terraform-provider-edge/internal/api"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -16,12 +16,20 @@ import (
 var stderr = os.Stderr
 
 func New() tfsdk.Provider {
-	return &provider{}
+	return &provider{references: newReferences()}
 }
 
 type provider struct {
 	configured bool
-	client     *edge.Client
+	config     api.Client
+	// username is the user the provider is logged in as.
+	username string
+	// host is the URL of the web interface the provider is connected to.
+	host string
+	// references are the firewall groups and rulesets planned so far.
+	references *references
+	// skipReferenceCheck turns off the check of references during plan.
+	skipReferenceCheck bool
 }
 
 func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -53,15 +61,21 @@ The Edge provider provides the ability to configure a Ubiquiti Edge device.
 				Optional:    true,
 				Description: "Specify if the connection to the Edge configuration API should be insecure. Can be set with `EDGE_INSECURE`.",
 			},
+			"skip_reference_check": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Specify if `terraform plan` should skip checking that the firewall groups and rulesets referred to exist on the router or are planned by the same run, e.g. because they are created by another configuration first. Can be set with `EDGE_SKIP_REFERENCE_CHECK`.",
+			},
 		},
 	}, nil
 }
 
 type providerData struct {
-	Username types.String `tfsdk:"username"`
-	Host     types.String `tfsdk:"host"`
-	Password types.String `tfsdk:"password"`
-	Insecure types.Bool   `tfsdk:"insecure"`
+	Username           types.String `tfsdk:"username"`
+	Host               types.String `tfsdk:"host"`
+	Password           types.String `tfsdk:"password"`
+	Insecure           types.Bool   `tfsdk:"insecure"`
+	SkipReferenceCheck types.Bool   `tfsdk:"skip_reference_check"`
 }
 
 func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
@@ -109,7 +123,19 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		}
 	}
 
-	c, err := edge.Login(host, insecure, username, password)
+	var skipReferenceCheck bool
+	{
+		if !config.SkipReferenceCheck.Null && !config.SkipReferenceCheck.Unknown {
+			skipReferenceCheck = config.SkipReferenceCheck.Value
+		}
+		if strings.ToUpper(os.Getenv("EDGE_SKIP_REFERENCE_CHECK")) == "TRUE" {
+			skipReferenceCheck = true
+		} else if strings.ToUpper(os.Getenv("EDGE_SKIP_REFERENCE_CHECK")) == "FALSE" {
+			skipReferenceCheck = false
+		}
+	}
+
+	httpClient, err := api.Login(host, insecure, username, password)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to configure provider",
@@ -118,22 +144,59 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		return
 	}
 
-	p.client = c
+	p.config = api.New(httpClient, host)
+	p.username = username
+	p.host = host
+	p.skipReferenceCheck = skipReferenceCheck
 	p.configured = true
 }
 
 func (p *provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"edge_firewall_ruleset":            resourceFirewallRulesetType{},
-		"edge_firewall_ruleset_attachment": resourceFirewallRulesetAttachmentType{},
-		"edge_firewall_address_group":      resourceFirewallAddressGroupType{},
-		"edge_firewall_port_group":         resourceFirewallPortGroupType{},
+		"edge_firewall_ruleset":              resourceFirewallRulesetType{},
+		"edge_firewall_rule":                 resourceFirewallRuleType{},
+		"edge_firewall_ruleset_attachment":   resourceFirewallRulesetAttachmentType{},
+		"edge_firewall_address_group":        resourceFirewallAddressGroupType{},
+		"edge_firewall_port_group":           resourceFirewallPortGroupType{},
+		"edge_firewall_address_group_member": resourceFirewallAddressGroupMemberType{},
+		"edge_firewall_port_group_member":    resourceFirewallPortGroupMemberType{},
+		"edge_firewall_modify_ruleset":       resourceFirewallModifyRulesetType{},
+		"edge_firewall_global_options":       resourceFirewallGlobalOptionsType{},
+		"edge_interface_bridge":              resourceInterfaceBridgeType{},
+		"edge_interface_switch":              resourceInterfaceSwitchType{},
+		"edge_static_route_table":            resourceStaticRouteTableType{},
+		"edge_load_balance_group":            resourceLoadBalanceGroupType{},
+		"edge_traffic_control_smart_queue":   resourceTrafficControlSmartQueueType{},
+		"edge_traffic_policy_shaper":         resourceTrafficPolicyShaperType{},
+		"edge_protocols_ospf":                resourceProtocolsOSPFType{},
+		"edge_protocols_bgp":                 resourceProtocolsBGPType{},
+		"edge_bgp_neighbor":                  resourceBGPNeighborType{},
+		"edge_prefix_list":                   resourcePrefixListType{},
+		"edge_route_map":                     resourceRouteMapType{},
+		"edge_vpn_ipsec_ike_group":           resourceVPNIPsecIKEGroupType{},
+		"edge_vpn_ipsec_esp_group":           resourceVPNIPsecESPGroupType{},
+		"edge_vpn_ipsec_site_to_site_peer":   resourceVPNIPsecSiteToSitePeerType{},
+		"edge_vpn_l2tp_remote_access":        resourceVPNL2TPRemoteAccessType{},
+		"edge_system_user":                   resourceSystemUserType{},
+		"edge_system":                        resourceSystemType{},
+		"edge_system_conntrack":              resourceSystemConntrackType{},
+		"edge_system_user_ssh_key":           resourceSystemUserSSHKeyType{},
+		"edge_system_syslog_host":            resourceSystemSyslogHostType{},
+		"edge_service_snmp":                  resourceServiceSNMPType{},
+		"edge_service_ssh":                   resourceServiceSSHType{},
+		"edge_service_gui":                   resourceServiceGUIType{},
+		"edge_service_upnp2":                 resourceServiceUPnP2Type{},
+		"edge_service_mdns_repeater":         resourceServiceMDNSRepeaterType{},
+		"edge_service_dns_dynamic":           resourceServiceDNSDynamicType{},
+		"edge_protocols_igmp_proxy":          resourceProtocolsIGMPProxyType{},
+		"edge_config_node":                   resourceConfigNodeType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
 		"edge_interface_ethernet": dataSourceInterfaceEthernetType{},
+		"edge_config":             dataSourceConfigType{},
 	}, nil
 }
 
@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	addressGroupsPath  = []string{"firewall", "group", "address-group"}
+	portGroupsPath     = []string{"firewall", "group", "port-group"}
+	rulesetsPath       = []string{"firewall", "name"}
+	modifyRulesetsPath = []string{"firewall", "modify"}
+)
+
+// references remembers the firewall groups and rulesets that are planned by
+// the current run of the provider. Terraform usually plans a resource before
+// the resources that refer to it, so a reference to a group that does not
+// exist on the router yet is fine as long as the group has been planned.
+//
+// It also holds the firewall node as it was when the first reference was
+// checked, so that it is fetched once per run rather than once per resource.
+type references struct {
+	mu       sync.Mutex
+	planned  map[string]bool
+	fetched  bool
+	firewall interface{}
+}
+
+func newReferences() *references {
+	return &references{planned: map[string]bool{}}
+}
+
+func (r *references) plan(path []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.planned[strings.Join(path, " ")] = true
+}
+
+func (r *references) isPlanned(path []string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.planned[strings.Join(path, " ")]
+}
+
+// firewallNode returns the firewall node of the router, fetching it on the
+// first call only.
+func (r *references) firewallNode(ctx context.Context, config api.Client) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fetched {
+		return r.firewall, nil
+	}
+
+	if err := config.Get(ctx, &r.firewall, "firewall"); err != nil && !api.IsNotFound(err) {
+		return nil, err
+	}
+	r.fetched = true
+	return r.firewall, nil
+}
+
+// reference is an attribute that holds the name of a firewall group or ruleset.
+type reference struct {
+	attribute *tftypes.AttributePath
+	kind      string
+	path      []string
+}
+
+// referrer returns the references held by a plan.
+type referrer func(ctx context.Context, plan tfsdk.Plan) ([]reference, diag.Diagnostics)
+
+// resourceWithReferences checks during plan that the firewall groups and
+// rulesets a resource refers to exist on the router or are planned as well. If
+// the resource is itself a group or ruleset, it records that it is planned.
+//
+// Names that are not known yet, e.g. because they refer to another resource,
+// are not checked. The check can be turned off with the provider's
+// `skip_reference_check` for groups and rulesets that are created by another
+// configuration or left out by `-target`.
+type resourceWithReferences struct {
+	utils.Resource
+	p provider
+	// provides is the path of the node the resource's name is created under.
+	provides []string
+	refers   referrer
+}
+
+func (r resourceWithReferences) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if !r.p.configured || r.p.references == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if r.provides != nil {
+		var name types.String
+		if diags := req.Plan.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"), &name); !diags.HasError() && !name.Unknown && !name.Null {
+			r.p.references.plan(append(append([]string{}, r.provides...), name.Value))
+		}
+	}
+
+	if r.refers == nil || r.p.skipReferenceCheck {
+		return
+	}
+
+	refs, diags := r.refers(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || len(refs) == 0 {
+		return
+	}
+
+	firewall, err := r.p.references.firewallNode(ctx, r.p.config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not verify the references of the %s.", r.Name),
+			err.Error(),
+		)
+		return
+	}
+
+	for _, ref := range refs {
+		if r.p.references.isPlanned(ref.path) {
+			continue
+		}
+		if _, ok := api.Lookup(firewall, ref.path[1:]...); ok {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			ref.attribute,
+			fmt.Sprintf("Unknown %s", ref.kind),
+			fmt.Sprintf("The %s `%s` does not exist on the router and is not planned by this run. Refer to it through the resource that manages it so that it is created first, or set the provider's `skip_reference_check` if it is created elsewhere.", ref.kind, ref.path[len(ref.path)-1]),
+		)
+	}
+}
+
+// referTo returns the reference held by the string attribute at attribute, if
+// it is known and set.
+func referTo(ctx context.Context, plan tfsdk.Plan, attribute *tftypes.AttributePath, kind string, path []string) ([]reference, diag.Diagnostics) {
+	var name types.String
+	diags := plan.GetAttribute(ctx, attribute, &name)
+	if diags.HasError() || name.Unknown || name.Null {
+		return nil, diags
+	}
+
+	return []reference{{
+		attribute: attribute,
+		kind:      kind,
+		path:      append(append([]string{}, path...), name.Value),
+	}}, diags
+}
+
+// ruleReferences returns the groups referred to by the source and destination
+// of every rule in the `rule` block of a ruleset.
+func ruleReferences(ctx context.Context, plan tfsdk.Plan) ([]reference, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, _, err := tftypes.WalkAttributePath(plan.Raw, tftypes.NewAttributePath().WithAttributeName("rule"))
+	if err != nil {
+		diags.AddError("Could not retrieve the rules from the plan.", err.Error())
+		return nil, diags
+	}
+
+	var rules []tftypes.Value
+	if v, ok := value.(tftypes.Value); !ok || !v.IsKnown() || v.IsNull() {
+		return nil, diags
+	} else if err := v.As(&rules); err != nil {
+		diags.AddError("Could not retrieve the rules from the plan.", err.Error())
+		return nil, diags
+	}
+
+	refs := []reference{}
+	for i := range rules {
+		r, d := endpointReferences(ctx, plan, tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyInt(i))
+		diags.Append(d...)
+		refs = append(refs, r...)
+	}
+	return refs, diags
+}
+
+// firewallRuleReferences returns the ruleset a standalone rule belongs to and
+// the groups referred to by its source and destination.
+func firewallRuleReferences(ctx context.Context, plan tfsdk.Plan) ([]reference, diag.Diagnostics) {
+	refs, diags := referTo(ctx, plan, tftypes.NewAttributePath().WithAttributeName("ruleset"), "ruleset", rulesetsPath)
+
+	r, d := endpointReferences(ctx, plan, tftypes.NewAttributePath())
+	diags.Append(d...)
+	return append(refs, r...), diags
+}
+
+// memberReferences returns a referrer for the group a group member belongs to.
+func memberReferences(kind string, path []string) referrer {
+	return func(ctx context.Context, plan tfsdk.Plan) ([]reference, diag.Diagnostics) {
+		return referTo(ctx, plan, tftypes.NewAttributePath().WithAttributeName("group"), kind, path)
+	}
+}
+
+// endpointReferences returns the groups referred to by the source and
+// destination of the rule at rule.
+func endpointReferences(ctx context.Context, plan tfsdk.Plan, rule *tftypes.AttributePath) ([]reference, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	refs := []reference{}
+
+	for _, endpoint := range []string{"source", "destination"} {
+		endpointPath := rule.WithAttributeName(endpoint)
+
+		for _, group := range []struct {
+			attribute string
+			kind      string
+			path      []string
+		}{
+			{attribute: "address_group", kind: "address group", path: addressGroupsPath},
+			{attribute: "port_group", kind: "port group", path: portGroupsPath},
+		} {
+			ref, d := referTo(ctx, plan, endpointPath.WithAttributeName(group.attribute), group.kind, group.path)
+			diags.Append(d...)
+			refs = append(refs, ref...)
+		}
+	}
+	return refs, diags
+}
+
+// attachmentReferences returns the rulesets referred to by an attachment.
+func attachmentReferences(ctx context.Context, plan tfsdk.Plan) ([]reference, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	refs := []reference{}
+
+	for _, direction := range []string{"in", "out", "local"} {
+		ref, d := referTo(ctx, plan, tftypes.NewAttributePath().WithAttributeName(direction), "ruleset", rulesetsPath)
+		diags.Append(d...)
+		refs = append(refs, ref...)
+	}
+
+	for _, direction := range []string{"in", "out"} {
+		ref, d := referTo(ctx, plan, tftypes.NewAttributePath().WithAttributeName("modify").WithAttributeName(direction), "modify ruleset", modifyRulesetsPath)
+		diags.Append(d...)
+		refs = append(refs, ref...)
+	}
+
+	return refs, diags
+}
+
+// groupReferrers returns the nodes of the configuration that refer to the
+// group at path, e.g. `ruleset WAN_IN rule 10`. These are the rules of the
+// rulesets and modify rulesets, the matches of the traffic shapers and the NAT
+// rules.
+func groupReferrers(config interface{}, path []string) []string {
+	group, name := path[len(path)-2], path[len(path)-1]
+
+	refersTo := func(node interface{}) bool {
+		for _, endpoint := range []string{"source", "destination"} {
+			if ref, _ := api.Lookup(node, endpoint, "group", group); ref == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	referrers := []string{}
+	for _, kind := range []struct {
+		path []string
+		name string
+	}{
+		{path: rulesetsPath, name: "ruleset"},
+		{path: modifyRulesetsPath, name: "modify ruleset"},
+	} {
+		rulesets, _ := api.Lookup(config, kind.path...)
+		for _, ruleset := range keys(rulesets) {
+			rules, _ := api.Lookup(rulesets, ruleset, "rule")
+			for _, priority := range keys(rules) {
+				if rule, _ := api.Lookup(rules, priority); refersTo(rule) {
+					referrers = append(referrers, fmt.Sprintf("%s `%s` rule %s", kind.name, ruleset, priority))
+				}
+			}
+		}
+	}
+
+	shapers, _ := api.Lookup(config, "traffic-policy", "shaper")
+	for _, shaper := range keys(shapers) {
+		classes, _ := api.Lookup(shapers, shaper, "class")
+		for _, class := range keys(classes) {
+			matches, _ := api.Lookup(classes, class, "match")
+			for _, match := range keys(matches) {
+				if ip, _ := api.Lookup(matches, match, "ip"); refersTo(ip) {
+					referrers = append(referrers, fmt.Sprintf("traffic shaper `%s` class %s match `%s`", shaper, class, match))
+				}
+			}
+		}
+	}
+
+	rules, _ := api.Lookup(config, "service", "nat", "rule")
+	for _, priority := range keys(rules) {
+		if rule, _ := api.Lookup(rules, priority); refersTo(rule) {
+			referrers = append(referrers, fmt.Sprintf("NAT rule %s", priority))
+		}
+	}
+
+	return referrers
+}
+
+// groupInUse returns an error listing the nodes that refer to the group at
+// path, if there are any. EdgeOS would otherwise refuse to commit the deletion
+// of the group with a generic error.
+func groupInUse(ctx context.Context, config api.Client, path []string, kind string) error {
+	var tree interface{}
+	if err := config.Get(ctx, &tree); err != nil {
+		return err
+	}
+
+	if referrers := groupReferrers(tree, path); len(referrers) > 0 {
+		return fmt.Errorf("The %s `%s` is still referred to by %s.", kind, path[len(path)-1], strings.Join(referrers, ", "))
+	}
+	return nil
+}
+
+// rulesetAttachment is a direction of an interface a ruleset is attached to.
+type rulesetAttachment struct {
+	iface     string
+	direction string
+	// path is the path of the node that holds the name of the ruleset.
+	path []string
+}
+
+// rulesetAttachments returns where the ruleset name is attached to in the
+// interfaces node.
+func rulesetAttachments(interfaces interface{}, name string) []rulesetAttachment {
+	attachments := []rulesetAttachment{}
+
+	var walk func(node interface{}, path []string)
+	walk = func(node interface{}, path []string) {
+		for _, key := range keys(node) {
+			child, _ := api.Lookup(node, key)
+			if key != "firewall" {
+				walk(child, append(append([]string{}, path...), key))
+				continue
+			}
+			for _, direction := range []string{"in", "out", "local"} {
+				if ruleset, _ := api.Lookup(child, direction, "name"); ruleset == name && len(path) >= 2 {
+					attachments = append(attachments, rulesetAttachment{
+						iface:     interfaceName(path),
+						direction: direction,
+						path:      append(append([]string{"interfaces"}, path...), "firewall", direction, "name"),
+					})
+				}
+			}
+		}
+	}
+	walk(interfaces, nil)
+
+	return attachments
+}
+
+// interfaceName is the inverse of interfacePath, e.g. `switch0.10` for
+// `switch switch0 vif 10`.
+func interfaceName(path []string) string {
+	n := len(path)
+	switch {
+	case n >= 4 && path[n-2] == "vif":
+		return path[n-3] + "." + path[n-1]
+	case n >= 4 && path[n-2] == "pppoe":
+		return "pppoe" + path[n-1]
+	}
+	return path[n-1]
+}
+
+// keys returns the keys of a node, ordered numerically when both are numbers,
+// or nothing if it has no children.
+func keys(node interface{}) []string {
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, errA := strconv.Atoi(keys[i])
+		b, errB := strconv.Atoi(keys[j])
+		if errA != nil || errB != nil {
+			return keys[i] < keys[j]
+		}
+		return a < b
+	})
+	return keys
+}
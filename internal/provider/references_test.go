@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeConfig serves Get from a fixed configuration tree.
+type fakeConfig struct {
+	api.Client
+	tree string
+}
+
+func (c fakeConfig) Get(_ context.Context, v interface{}, path ...string) error {
+	var tree interface{}
+	if err := json.Unmarshal([]byte(c.tree), &tree); err != nil {
+		return err
+	}
+	node, ok := api.Lookup(tree, path...)
+	if !ok {
+		return &api.NotFoundError{Path: path}
+	}
+	data, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func TestRulesetReferences(t *testing.T) {
+	ctx := context.Background()
+	p := provider{
+		configured: true,
+		config:     fakeConfig{tree: `{"firewall":{"group":{"address-group":{"kids":{"address":["192.168.1.10"]}}}}}`},
+		references: newReferences(),
+	}
+	p.references.plan(append(portGroupsPath, "web"))
+
+	schema := schemaFirewallRuleset()
+	typ := schema.TerraformType(ctx).(tftypes.Object)
+	ruleType := typ.AttributeTypes["rule"].(tftypes.List).ElementType.(tftypes.Object)
+	sourceType := ruleType.AttributeTypes["source"].(tftypes.Object)
+	destinationType := ruleType.AttributeTypes["destination"].(tftypes.Object)
+
+	rule := objectValue(ruleType, map[string]tftypes.Value{
+		"priority": tftypes.NewValue(tftypes.Number, 10),
+		"action":   tftypes.NewValue(tftypes.String, "drop"),
+		"protocol": tftypes.NewValue(tftypes.String, "tcp"),
+		"source": objectValue(sourceType, map[string]tftypes.Value{
+			"address_group": tftypes.NewValue(tftypes.String, "kids"),
+			"port_group":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		"destination": objectValue(destinationType, map[string]tftypes.Value{
+			"address_group": tftypes.NewValue(tftypes.String, "typo"),
+			"port_group":    tftypes.NewValue(tftypes.String, "web"),
+		}),
+	})
+
+	r := resourceWithReferences{
+		Resource: utils.Resource{Name: "firewall ruleset"},
+		p:        p,
+		provides: rulesetsPath,
+		refers:   ruleReferences,
+	}
+
+	var resp tfsdk.ModifyResourcePlanResponse
+	r.ModifyPlan(ctx, tfsdk.ModifyResourcePlanRequest{
+		Plan: tfsdk.Plan{
+			Schema: schema,
+			Raw: objectValue(typ, map[string]tftypes.Value{
+				"name":           tftypes.NewValue(tftypes.String, "LAN_IN"),
+				"default_action": tftypes.NewValue(tftypes.String, "accept"),
+				"rule":           tftypes.NewValue(typ.AttributeTypes["rule"], []tftypes.Value{rule}),
+			}),
+		},
+	}, &resp)
+
+	expected := tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyInt(0).WithAttributeName("destination").WithAttributeName("address_group")
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %v", resp.Diagnostics)
+	}
+	if d, ok := resp.Diagnostics[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(expected) || d.Severity() != diag.SeverityError {
+		t.Errorf("expected an error for %s, got %v", expected, resp.Diagnostics[0])
+	}
+
+	if !p.references.isPlanned(append(rulesetsPath, "LAN_IN")) {
+		t.Error("expected the ruleset to be planned")
+	}
+}
+
+func TestAttachmentReferences(t *testing.T) {
+	ctx := context.Background()
+	p := provider{
+		configured: true,
+		config:     fakeConfig{tree: `{"firewall":{"name":{"WAN_IN":{"default-action":"drop"}}}}`},
+		references: newReferences(),
+	}
+
+	schema := schemaFirewallRulesetAttachment()
+	typ := schema.TerraformType(ctx).(tftypes.Object)
+
+	r := resourceWithReferences{
+		Resource: utils.Resource{Name: "firewall ruleset attachment"},
+		p:        p,
+		refers:   attachmentReferences,
+	}
+
+	req := tfsdk.ModifyResourcePlanRequest{
+		Plan: tfsdk.Plan{
+			Schema: schema,
+			Raw: objectValue(typ, map[string]tftypes.Value{
+				"interface": tftypes.NewValue(tftypes.String, "eth0"),
+				"in":        tftypes.NewValue(tftypes.String, "WAN_IN"),
+				"local":     tftypes.NewValue(tftypes.String, "WAN_LOCAL"),
+			}),
+		},
+	}
+
+	var resp tfsdk.ModifyResourcePlanResponse
+	r.ModifyPlan(ctx, req, &resp)
+
+	expected := tftypes.NewAttributePath().WithAttributeName("local")
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %v", resp.Diagnostics)
+	}
+	if d, ok := resp.Diagnostics[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(expected) || d.Severity() != diag.SeverityError {
+		t.Errorf("expected an error for %s, got %v", expected, resp.Diagnostics[0])
+	}
+
+	r.p.skipReferenceCheck = true
+	resp = tfsdk.ModifyResourcePlanResponse{}
+	r.ModifyPlan(ctx, req, &resp)
+	if len(resp.Diagnostics) != 0 {
+		t.Errorf("expected the check to be skipped, got %v", resp.Diagnostics)
+	}
+}
+
+// countingConfig counts how often the configuration is fetched.
+type countingConfig struct {
+	fakeConfig
+	gets *int
+}
+
+func (c countingConfig) Get(ctx context.Context, v interface{}, path ...string) error {
+	*c.gets++
+	return c.fakeConfig.Get(ctx, v, path...)
+}
+
+func TestReferencesFirewallNode(t *testing.T) {
+	gets := 0
+	config := countingConfig{fakeConfig: fakeConfig{tree: `{"firewall":{"name":{"WAN_IN":{"default-action":"drop"}}}}`}, gets: &gets}
+	r := newReferences()
+
+	for i := 0; i < 3; i++ {
+		firewall, err := r.firewallNode(context.Background(), config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := api.Lookup(firewall, "name", "WAN_IN"); !ok {
+			t.Errorf("expected the firewall node, got %v", firewall)
+		}
+	}
+
+	if gets != 1 {
+		t.Errorf("expected the firewall node to be fetched once, got %d", gets)
+	}
+}
+
+func TestGroupInUse(t *testing.T) {
+	config := fakeConfig{tree: `{"firewall":{
+		"name":{"WAN_IN":{"rule":{
+			"10":{"action":"accept","source":{"group":{"address-group":"kids"}}},
+			"5":{"action":"drop","destination":{"group":{"address-group":"kids","port-group":"web"}}}
+		}}},
+		"modify":{"PBR":{"rule":{"10":{"action":"modify","source":{"group":{"address-group":"kids"}}}}}}
+	},
+	"traffic-policy":{"shaper":{"WAN_OUT":{"class":{"10":{"match":{
+		"games":{"ip":{"source":{"group":{"address-group":"kids"}}}},
+		"voip":{"ip":{"destination":{"group":{"port-group":"voip"}}}}
+	}}}}}},
+	"service":{"nat":{"rule":{"5000":{"type":"masquerade","source":{"group":{"address-group":"kids"}}}}}}}`}
+
+	err := groupInUse(context.Background(), config, append(append([]string{}, addressGroupsPath...), "kids"), "address group")
+	const expected = "The address group `kids` is still referred to by ruleset `WAN_IN` rule 5, ruleset `WAN_IN` rule 10, modify ruleset `PBR` rule 10, traffic shaper `WAN_OUT` class 10 match `games`, NAT rule 5000."
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	if err := groupInUse(context.Background(), config, append(append([]string{}, portGroupsPath...), "ssh"), "port group"); err != nil {
+		t.Errorf("expected the port group not to be in use, got %v", err)
+	}
+
+	err = groupInUse(context.Background(), config, append(append([]string{}, portGroupsPath...), "voip"), "port group")
+	const shaper = "The port group `voip` is still referred to by traffic shaper `WAN_OUT` class 10 match `voip`."
+	if err == nil || err.Error() != shaper {
+		t.Errorf("expected %s, got %v", shaper, err)
+	}
+}
+
+func TestRulesetAttachments(t *testing.T) {
+	var interfaces interface{}
+	if err := json.Unmarshal([]byte(`{
+		"ethernet":{
+			"eth0":{"firewall":{"in":{"name":"WAN_IN"},"local":{"name":"WAN_LOCAL"}}},
+			"eth1":{"pppoe":{"0":{"firewall":{"local":{"name":"WAN_IN"}}}}}
+		},
+		"switch":{"switch0":{"vif":{"10":{"firewall":{"out":{"name":"WAN_IN","modify":"PBR"}}}}}}
+	}`), &interfaces); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := rulesetAttachments(interfaces, "WAN_IN")
+	expected := []rulesetAttachment{
+		{iface: "eth0", direction: "in", path: []string{"interfaces", "ethernet", "eth0", "firewall", "in", "name"}},
+		{iface: "pppoe0", direction: "local", path: []string{"interfaces", "ethernet", "eth1", "pppoe", "0", "firewall", "local", "name"}},
+		{iface: "switch0.10", direction: "out", path: []string{"interfaces", "switch", "switch0", "vif", "10", "firewall", "out", "name"}},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
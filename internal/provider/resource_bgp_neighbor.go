@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceBGPNeighborType struct{}
+
+func (r resourceBGPNeighborType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaBGPNeighbor(), nil
+}
+
+func (r resourceBGPNeighborType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "bgp neighbor",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceBGPNeighbor{p: *(p.(*provider))},
+		Type:         types.BGPNeighbor{},
+	}, nil
+}
+
+type resourceBGPNeighbor struct {
+	p provider
+}
+
+func bgpNeighborPath(asn int, address string) []string {
+	return append(bgpPath(strconv.Itoa(asn)), "neighbor", address)
+}
+
+func parseBGPNeighborID(id string) (int, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", fmt.Errorf("The id `%s` is not of the form `<asn>/<address>`.", id)
+	}
+
+	asn, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("The id `%s` is not of the form `<asn>/<address>`.", id)
+	}
+	return asn, parts[1], nil
+}
+
+func (r resourceBGPNeighbor) Read(ctx context.Context, id string) (interface{}, error) {
+	asn, address, err := parseBGPNeighborID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var neighbor types.BGPNeighbor
+	if err := r.p.config.Get(ctx, &neighbor, bgpNeighborPath(asn, address)...); err != nil {
+		return nil, err
+	}
+	neighbor.ASN, neighbor.Address = asn, address
+	return &neighbor, nil
+}
+
+func (r resourceBGPNeighbor) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.BGPNeighbor)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+func (r resourceBGPNeighbor) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	neighbor := plan.(types.BGPNeighbor)
+	if err := r.p.config.Set(ctx, neighbor, bgpNeighborPath(neighbor.ASN, neighbor.Address)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, neighbor)
+}
+
+func (r resourceBGPNeighbor) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	neighbor := desired.(types.BGPNeighbor)
+	if err := r.p.config.Update(ctx, current, neighbor, bgpNeighborPath(neighbor.ASN, neighbor.Address)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, neighbor)
+}
+
+func (r resourceBGPNeighbor) Delete(ctx context.Context, id string) error {
+	asn, address, err := parseBGPNeighborID(id)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Delete(ctx, bgpNeighborPath(asn, address)...)
+}
+
+func (r resourceBGPNeighbor) read(ctx context.Context, known types.BGPNeighbor) (interface{}, error) {
+	actual, err := r.Read(ctx, known.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceConfigNodeType struct{}
+
+func (r resourceConfigNodeType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaConfigNode(), nil
+}
+
+func (r resourceConfigNodeType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "config node",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceConfigNode{p: *(p.(*provider))},
+		Type:         types.ConfigNode{},
+	}, nil
+}
+
+type resourceConfigNode struct {
+	p provider
+}
+
+// configNodeID returns the id of the node at path.
+func configNodeID(path []string) string {
+	node := types.ConfigNode{Path: path}
+	return node.GetID()
+}
+
+// parseConfigNodeID returns the path of the id, which is either separated by
+// spaces or a JSON array.
+func parseConfigNodeID(id string) ([]string, error) {
+	var path []string
+	if strings.HasPrefix(id, "[") {
+		if err := json.Unmarshal([]byte(id), &path); err != nil {
+			return nil, fmt.Errorf("The id `%s` is not a JSON array of strings: %w", id, err)
+		}
+	} else {
+		path = strings.Fields(id)
+	}
+	if len(path) == 0 {
+		return nil, fmt.Errorf("The id `%s` does not contain a path.", id)
+	}
+	return path, nil
+}
+
+func (r resourceConfigNode) Read(ctx context.Context, id string) (interface{}, error) {
+	path, err := parseConfigNodeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var node types.ConfigNode
+	if err := r.p.config.Get(ctx, &node, path...); err != nil {
+		return nil, err
+	}
+	node.Path = path
+	return &node, nil
+}
+
+// Normalize keeps the JSON as it was written if it is equivalent to what the
+// router reports.
+func (r resourceConfigNode) Normalize(known, actual interface{}) interface{} {
+	k, a := known.(types.ConfigNode), actual.(*types.ConfigNode)
+	if k.JSON != nil && a.JSON != nil && equalJSON(*k.JSON, *a.JSON) {
+		a.JSON = k.JSON
+	}
+	return a
+}
+
+func equalJSON(a, b string) bool {
+	var x, y interface{}
+	if err := json.Unmarshal([]byte(a), &x); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// Create refuses to take over a node that already exists, as the subtree at
+// the path would otherwise be merged with what is already there.
+func (r resourceConfigNode) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	node := plan.(types.ConfigNode)
+
+	var existing interface{}
+	if err := r.p.config.Get(ctx, &existing, node.Path...); err == nil {
+		return nil, fmt.Errorf("The node `%s` already exists. Import it with the id `%s` to manage it.", strings.Join(node.Path, " "), node.GetID())
+	} else if !api.IsNotFound(err) {
+		return nil, err
+	}
+
+	if err := r.p.config.Set(ctx, node, node.Path...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, node)
+}
+
+func (r resourceConfigNode) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	node := desired.(types.ConfigNode)
+	if err := r.p.config.Update(ctx, current, node, node.Path...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, node)
+}
+
+func (r resourceConfigNode) Delete(ctx context.Context, id string) error {
+	path, err := parseConfigNodeID(id)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Delete(ctx, path...)
+}
+
+func (r resourceConfigNode) read(ctx context.Context, known types.ConfigNode) (interface{}, error) {
+	actual, err := r.Read(ctx, known.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
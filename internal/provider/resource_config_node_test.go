@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-edge/internal/types"
+)
+
+func TestParseConfigNodeID(t *testing.T) {
+	for _, test := range []struct {
+		path []string
+		id   string
+	}{
+		{path: []string{"service", "lldp", "interface", "all"}, id: "service lldp interface all"},
+		{path: []string{"system", "login", "banner", "pre-login", "Authorized use only"}, id: `["system","login","banner","pre-login","Authorized use only"]`},
+		{path: []string{"service", "lldp", "legacy-protocols", ""}, id: `["service","lldp","legacy-protocols",""]`},
+		{path: []string{"service", "[brackets]"}, id: `["service","[brackets]"]`},
+		{path: []string{"service", "lldp interface", "all"}, id: `["service","lldp interface","all"]`},
+	} {
+		node := types.ConfigNode{Path: test.path}
+		if id := node.GetID(); id != test.id {
+			t.Errorf("expected the id %s, got %s", test.id, id)
+		}
+		if id := configNodeID(test.path); id != test.id {
+			t.Errorf("expected the data source id %s, got %s", test.id, id)
+		}
+		path, err := parseConfigNodeID(test.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(test.path, path) {
+			t.Errorf("expected the path %q, got %q", test.path, path)
+		}
+	}
+
+	for _, id := range []string{"", "   ", "[]", `["service"`, `[1, 2]`} {
+		if _, err := parseConfigNodeID(id); err == nil {
+			t.Errorf("expected an error for %s", id)
+		}
+	}
+}
+
+func TestConfigNodeCreateExisting(t *testing.T) {
+	r := resourceConfigNode{p: provider{config: fakeConfig{tree: `{"service":{"lldp":{"interface":{"all":null}}}}`}}}
+
+	const expected = "The node `service lldp` already exists. Import it with the id `service lldp` to manage it."
+	_, err := r.Create(context.Background(), types.ConfigNode{Path: []string{"service", "lldp"}})
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
@@ -3,9 +3,9 @@ package provider
 import (
 	"context"
 
-	"github.com/frankgreco/edge-sdk-go/types"
 	"github.com/mattbaird/jsonpatch"
 
+	"terraform-provider-edge/internal/types"
 	"terraform-provider-edge/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,12 +19,16 @@ func (r resourceFirewallAddressGroupType) GetSchema(_ context.Context) (tfsdk.Sc
 }
 
 func (r resourceFirewallAddressGroupType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return utils.Resource{
-		Name:         "firewall address group",
-		Attribute:    "name",
-		IsConfigured: (p.(*provider)).configured,
-		Api:          resourceFirewallAddressGroup{p: *(p.(*provider))},
-		Type:         types.AddressGroup{},
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall address group",
+			Attribute:    "name",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallAddressGroup{p: *(p.(*provider))},
+			Type:         types.AddressGroup{},
+		},
+		p:        *(p.(*provider)),
+		provides: addressGroupsPath,
 	}, nil
 }
 
@@ -32,20 +36,63 @@ type resourceFirewallAddressGroup struct {
 	p provider
 }
 
+func addressGroupPath(name string) []string {
+	return append(append([]string{}, addressGroupsPath...), name)
+}
+
 func (r resourceFirewallAddressGroup) Read(ctx context.Context, id string) (interface{}, error) {
-	return r.p.client.Firewall.GetAddressGroup(ctx, id)
+	var group types.AddressGroup
+	if err := r.p.config.Get(ctx, &group, addressGroupPath(id)...); err != nil {
+		return nil, err
+	}
+	group.Name = id
+	return &group, nil
+}
+
+// Normalize drops the cidrs that are not known if `ignore_undeclared_members`
+// is set, and restores the attribute itself.
+func (r resourceFirewallAddressGroup) Normalize(known, actual interface{}) interface{} {
+	k, a := known.(types.AddressGroup), actual.(*types.AddressGroup)
+	if k.IgnoreUndeclared != nil && *k.IgnoreUndeclared {
+		var declared []string
+		for _, cidr := range a.Cidrs {
+			if contains(k.Cidrs, cidr) {
+				declared = append(declared, cidr)
+			}
+		}
+		a.Cidrs = declared
+	}
+	a.IgnoreUndeclared = k.IgnoreUndeclared
+	return a
 }
 
 func (r resourceFirewallAddressGroup) Create(ctx context.Context, plan interface{}) (interface{}, error) {
 	group := plan.(types.AddressGroup)
-	return r.p.client.Firewall.CreateAddressGroup(ctx, &group)
+	if err := r.p.config.Set(ctx, group, addressGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, group)
 }
 
-func (r resourceFirewallAddressGroup) Update(ctx context.Context, current, desired interface{}, patches []jsonpatch.JsonPatchOperation) (interface{}, error) {
-	group := current.(types.AddressGroup)
-	return r.p.client.Firewall.UpdateAddressGroup(ctx, &group, patches)
+func (r resourceFirewallAddressGroup) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	group := desired.(types.AddressGroup)
+	if err := r.p.config.Update(ctx, current, group, addressGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, group)
 }
 
 func (r resourceFirewallAddressGroup) Delete(ctx context.Context, id string) error {
-	return r.p.client.Firewall.DeleteAddressGroup(ctx, id)
+	if err := groupInUse(ctx, r.p.config, addressGroupPath(id), "address group"); err != nil {
+		return err
+	}
+	return r.p.config.Delete(ctx, addressGroupPath(id)...)
+}
+
+func (r resourceFirewallAddressGroup) read(ctx context.Context, known types.AddressGroup) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
 }
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceFirewallAddressGroupMemberType struct{}
+
+func (r resourceFirewallAddressGroupMemberType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaFirewallAddressGroupMember(), nil
+}
+
+func (r resourceFirewallAddressGroupMemberType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall address group member",
+			Attribute:    "id",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallAddressGroupMember{p: *(p.(*provider))},
+			Type:         types.AddressGroupMember{},
+		},
+		p:      *(p.(*provider)),
+		refers: memberReferences("address group", addressGroupsPath),
+	}, nil
+}
+
+type resourceFirewallAddressGroupMember struct {
+	p provider
+}
+
+func addressGroupMembersPath(group string) []string {
+	return append(addressGroupPath(group), "address")
+}
+
+// parseGroupMemberID splits the id of a group member into the group and the
+// member. The member may contain a slash itself, as cidrs do.
+func parseGroupMemberID(id, member string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("The id `%s` is not of the form `<group>/<%s>`.", id, member)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (r resourceFirewallAddressGroupMember) Read(ctx context.Context, id string) (interface{}, error) {
+	group, cidr, err := parseGroupMemberID(id, "cidr")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := readGroupMember(ctx, r.p.config, addressGroupMembersPath(group), cidr); err != nil {
+		return nil, err
+	}
+	return &types.AddressGroupMember{Group: group, Cidr: cidr}, nil
+}
+
+func (r resourceFirewallAddressGroupMember) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	member := plan.(types.AddressGroupMember)
+	if err := addGroupMember(ctx, r.p.config, addressGroupMembersPath(member.Group), member.Cidr); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, member.GetID())
+}
+
+// Update only reads the member back as every attribute forces a replacement.
+func (r resourceFirewallAddressGroupMember) Update(ctx context.Context, _, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	member := desired.(types.AddressGroupMember)
+	return r.Read(ctx, member.GetID())
+}
+
+func (r resourceFirewallAddressGroupMember) Delete(ctx context.Context, id string) error {
+	group, cidr, err := parseGroupMemberID(id, "cidr")
+	if err != nil {
+		return err
+	}
+	return r.p.config.Post(ctx, &api.Operation{
+		Delete: api.Nest([]interface{}{cidr}, addressGroupMembersPath(group)...),
+	})
+}
+
+// readGroupMember returns a not found error unless member is one of the values
+// of the multi-valued node at path.
+func readGroupMember(ctx context.Context, config api.Client, path []string, member string) error {
+	var members []string
+	if err := config.Get(ctx, &members, path...); err != nil {
+		return err
+	}
+	if !contains(members, member) {
+		return &api.NotFoundError{Path: append(path, member)}
+	}
+	return nil
+}
+
+// addGroupMember adds member to the values of the multi-valued node at path.
+// It refuses to create the group, as a typo in its name would otherwise
+// create a group nobody manages, and to take over a member that already
+// exists, as deleting this resource would otherwise remove a member someone
+// else declared.
+func addGroupMember(ctx context.Context, config api.Client, path []string, member string) error {
+	group := path[len(path)-2]
+
+	var node interface{}
+	if err := config.Get(ctx, &node, path[:len(path)-1]...); api.IsNotFound(err) {
+		return fmt.Errorf("The group `%s` does not exist. It must be created before members can be added to it.", group)
+	} else if err != nil {
+		return err
+	}
+
+	if err := readGroupMember(ctx, config, path, member); err == nil {
+		return fmt.Errorf("`%s` is already a member of the group `%s`.", member, group)
+	} else if !api.IsNotFound(err) {
+		return err
+	}
+	return config.Set(ctx, []string{member}, path...)
+}
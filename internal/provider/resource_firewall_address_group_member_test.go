@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-edge/internal/api"
+)
+
+func TestReadGroupMember(t *testing.T) {
+	ctx := context.Background()
+	config := fakeConfig{tree: `{"firewall":{"group":{
+		"address-group":{"kids":{"address":["192.168.1.10/32","192.168.1.11/32"]}},
+		"port-group":{"web":{"port":["80","8000-8100"]}}
+	}}}`}
+
+	for _, test := range []struct {
+		path   []string
+		member string
+		found  bool
+	}{
+		{path: addressGroupMembersPath("kids"), member: "192.168.1.11/32", found: true},
+		{path: addressGroupMembersPath("kids"), member: "192.168.1.12/32", found: false},
+		{path: addressGroupMembersPath("guests"), member: "192.168.1.10/32", found: false},
+		{path: portGroupMembersPath("web"), member: "8000-8100", found: true},
+		{path: portGroupMembersPath("web"), member: "8000", found: false},
+	} {
+		err := readGroupMember(ctx, config, test.path, test.member)
+		if test.found && err != nil {
+			t.Errorf("expected %s to be found, got %v", test.member, err)
+		}
+		if !test.found && !api.IsNotFound(err) {
+			t.Errorf("expected %s not to be found, got %v", test.member, err)
+		}
+	}
+
+	const expected = "`192.168.1.10/32` is already a member of the group `kids`."
+	if err := addGroupMember(ctx, config, addressGroupMembersPath("kids"), "192.168.1.10/32"); err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+
+	for group, path := range map[string][]string{
+		"guests": addressGroupMembersPath("guests"),
+		"mail":   portGroupMembersPath("mail"),
+	} {
+		expected := "The group `" + group + "` does not exist. It must be created before members can be added to it."
+		if err := addGroupMember(ctx, config, path, "25"); err == nil || err.Error() != expected {
+			t.Errorf("expected %s, got %v", expected, err)
+		}
+	}
+}
+
+func TestParsePortGroupMemberID(t *testing.T) {
+	for id, expected := range map[string]string{
+		"web/80":        "web/80",
+		"web/8000-8100": "web/8000-8100",
+	} {
+		member, err := parsePortGroupMemberID(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if member.GetID() != expected {
+			t.Errorf("expected %s, got %s", expected, member.GetID())
+		}
+	}
+
+	for _, id := range []string{"web", "web/", "/80", "web/http", "web/80-"} {
+		if _, err := parsePortGroupMemberID(id); err == nil {
+			t.Errorf("expected an error for %s", id)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var firewallPath = []string{"firewall"}
+
+// firewallGlobalOptionsDefaults are the factory defaults of the settings
+// managed by edge_firewall_global_options.
+var firewallGlobalOptionsDefaults = types.FirewallGlobalOptions{
+	AllPing:              togglePtr(true),
+	BroadcastPing:        togglePtr(false),
+	IPSourceRoute:        togglePtr(false),
+	LogMartians:          togglePtr(true),
+	ReceiveRedirects:     togglePtr(false),
+	SendRedirects:        togglePtr(true),
+	SourceValidation:     strptr("disable"),
+	SynCookies:           togglePtr(true),
+	IPv6ReceiveRedirects: togglePtr(false),
+	IPv6SourceRoute:      togglePtr(false),
+}
+
+type resourceFirewallGlobalOptionsType struct{}
+
+func (r resourceFirewallGlobalOptionsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaFirewallGlobalOptions(), nil
+}
+
+func (r resourceFirewallGlobalOptionsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "firewall global options",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceFirewallGlobalOptions{p: *(p.(*provider))},
+		Type:         types.FirewallGlobalOptions{},
+	}, nil
+}
+
+type resourceFirewallGlobalOptions struct {
+	p provider
+}
+
+// Read only decodes the global options. Rulesets, groups and the other children
+// of the firewall node are managed by their own resources.
+func (r resourceFirewallGlobalOptions) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.FirewallGlobalOptionsID {
+		return nil, fmt.Errorf("The firewall global options are identified by `%s`.", types.FirewallGlobalOptionsID)
+	}
+
+	var options types.FirewallGlobalOptions
+	if err := r.p.config.Get(ctx, &options, firewallPath...); err != nil {
+		return nil, err
+	}
+	return &options, nil
+}
+
+// Create adopts the existing options as the firewall node always exists.
+func (r resourceFirewallGlobalOptions) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	current, err := r.Read(ctx, types.FirewallGlobalOptionsID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Update(ctx, *(current.(*types.FirewallGlobalOptions)), plan, nil)
+}
+
+func (r resourceFirewallGlobalOptions) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, firewallPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.FirewallGlobalOptionsID)
+}
+
+// Delete restores the factory defaults rather than deleting the firewall node.
+func (r resourceFirewallGlobalOptions) Delete(ctx context.Context, _ string) error {
+	current, err := r.Read(ctx, types.FirewallGlobalOptionsID)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Update(ctx, *(current.(*types.FirewallGlobalOptions)), firewallGlobalOptionsDefaults, firewallPath...)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceFirewallModifyRulesetType struct{}
+
+func (r resourceFirewallModifyRulesetType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaFirewallModifyRuleset(), nil
+}
+
+func (r resourceFirewallModifyRulesetType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall modify ruleset",
+			Attribute:    "name",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallModifyRuleset{p: *(p.(*provider))},
+			Type:         types.ModifyRuleset{},
+		},
+		p:        *(p.(*provider)),
+		provides: modifyRulesetsPath,
+		refers:   ruleReferences,
+	}, nil
+}
+
+type resourceFirewallModifyRuleset struct {
+	p provider
+}
+
+func modifyRulesetPath(name string) []string {
+	return []string{"firewall", "modify", name}
+}
+
+func (r resourceFirewallModifyRuleset) Read(ctx context.Context, id string) (interface{}, error) {
+	var ruleset types.ModifyRuleset
+	if err := r.p.config.Get(ctx, &ruleset, modifyRulesetPath(id)...); err != nil {
+		return nil, err
+	}
+	ruleset.Name = id
+	return &ruleset, nil
+}
+
+// Normalize lines up the rules by priority before normalizing them and keeps
+// them in the order they are known in. A protocol of `*` is never written to
+// the router so it is restored from what is known.
+func (r resourceFirewallModifyRuleset) Normalize(known, actual interface{}) interface{} {
+	k, a := known.(types.ModifyRuleset), actual.(*types.ModifyRuleset)
+
+	rules, order := map[int]*types.ModifyRule{}, []int{}
+	for _, rule := range k.Rules {
+		rules[rule.Priority] = rule
+		order = append(order, rule.Priority)
+	}
+
+	for _, rule := range a.Rules {
+		desired, ok := rules[rule.Priority]
+		if !ok {
+			continue
+		}
+		if p := desired.Protocol; p != nil && *p == "*" && rule.Protocol == nil {
+			rule.Protocol = p
+		}
+		utils.Normalize(desired, rule)
+	}
+
+	less := byKnownOrder(order)
+	sort.SliceStable(a.Rules, func(i, j int) bool {
+		return less(a.Rules[i].Priority, a.Rules[j].Priority)
+	})
+
+	k.Rules = nil
+	utils.Normalize(&k, a)
+	return a
+}
+
+func (r resourceFirewallModifyRuleset) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	ruleset := plan.(types.ModifyRuleset)
+	if err := r.p.config.Set(ctx, ruleset, modifyRulesetPath(ruleset.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, ruleset)
+}
+
+func (r resourceFirewallModifyRuleset) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	ruleset := desired.(types.ModifyRuleset)
+
+	op, err := modifyRulesetOperation(current.(types.ModifyRuleset), ruleset)
+	if err != nil {
+		return nil, err
+	}
+	if !op.IsEmpty() {
+		if err := r.p.config.Post(ctx, op); err != nil {
+			return nil, err
+		}
+	}
+	return r.read(ctx, ruleset)
+}
+
+// modifyRulesetOperation returns the operation that transforms current into
+// desired. Only the rules that changed are touched.
+func modifyRulesetOperation(current, desired types.ModifyRuleset) (*api.Operation, error) {
+	path := modifyRulesetPath(desired.Name)
+
+	c, d := map[string]interface{}{}, map[string]interface{}{}
+	for _, rule := range current.Rules {
+		c[strconv.Itoa(rule.Priority)] = rule
+	}
+	for _, rule := range desired.Rules {
+		d[strconv.Itoa(rule.Priority)] = rule
+	}
+
+	current.Rules, desired.Rules = nil, nil
+	op, err := api.NewUpdate(current, desired, path...)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := rulesOperation(append(path, "rule"), c, d)
+	if err != nil {
+		return nil, err
+	}
+	return op.Merge(rules), nil
+}
+
+func (r resourceFirewallModifyRuleset) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, modifyRulesetPath(id)...)
+}
+
+func (r resourceFirewallModifyRuleset) read(ctx context.Context, known types.ModifyRuleset) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-edge/internal/types"
+)
+
+func TestModifyRulesetNormalize(t *testing.T) {
+	known := func(priorities ...int) types.ModifyRuleset {
+		ruleset := types.ModifyRuleset{Name: "PBR"}
+		for _, priority := range priorities {
+			ruleset.Rules = append(ruleset.Rules, &types.ModifyRule{
+				Priority: priority,
+				Action:   "modify",
+				Log:      togglePtr(false),
+				Protocol: strptr("*"),
+			})
+		}
+		return ruleset
+	}
+	actual := func(priorities ...int) *types.ModifyRuleset {
+		ruleset := &types.ModifyRuleset{Name: "PBR"}
+		for _, priority := range priorities {
+			ruleset.Rules = append(ruleset.Rules, &types.ModifyRule{
+				Priority: priority,
+				Action:   "modify",
+			})
+		}
+		return ruleset
+	}
+	normalized := func(priority int) *types.ModifyRule {
+		return &types.ModifyRule{
+			Priority: priority,
+			Action:   "modify",
+			Log:      togglePtr(false),
+			Protocol: strptr("*"),
+		}
+	}
+
+	for _, test := range []struct {
+		name     string
+		known    types.ModifyRuleset
+		actual   *types.ModifyRuleset
+		expected []*types.ModifyRule
+	}{
+		{
+			name:     "unchanged",
+			known:    known(10, 30),
+			actual:   actual(10, 30),
+			expected: []*types.ModifyRule{normalized(10), normalized(30)},
+		},
+		{
+			name:     "declared out of order",
+			known:    known(30, 10),
+			actual:   actual(10, 30),
+			expected: []*types.ModifyRule{normalized(30), normalized(10)},
+		},
+		{
+			name:     "extra rule",
+			known:    known(10, 30),
+			actual:   actual(10, 20, 30),
+			expected: []*types.ModifyRule{normalized(10), normalized(30), {Priority: 20, Action: "modify"}},
+		},
+		{
+			name:     "missing rule",
+			known:    known(10, 20, 30),
+			actual:   actual(10, 30),
+			expected: []*types.ModifyRule{normalized(10), normalized(30)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ruleset := resourceFirewallModifyRuleset{}.Normalize(test.known, test.actual).(*types.ModifyRuleset)
+			if !reflect.DeepEqual(test.expected, ruleset.Rules) {
+				t.Errorf("expected %+v, got %+v", test.expected, ruleset.Rules)
+			}
+		})
+	}
+}
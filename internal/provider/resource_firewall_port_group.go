@@ -3,9 +3,9 @@ package provider
 import (
 	"context"
 
-	"github.com/frankgreco/edge-sdk-go/types"
 	"github.com/mattbaird/jsonpatch"
 
+	"terraform-provider-edge/internal/types"
 	"terraform-provider-edge/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,12 +19,16 @@ func (r resourceFirewallPortGroupType) GetSchema(_ context.Context) (tfsdk.Schem
 }
 
 func (r resourceFirewallPortGroupType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return utils.Resource{
-		Name:         "firewall port group",
-		Attribute:    "name",
-		IsConfigured: (p.(*provider)).configured,
-		Api:          resourceFirewallPortGroup{p: *(p.(*provider))},
-		Type:         types.PortGroup{},
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall port group",
+			Attribute:    "name",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallPortGroup{p: *(p.(*provider))},
+			Type:         types.PortGroup{},
+		},
+		p:        *(p.(*provider)),
+		provides: portGroupsPath,
 	}, nil
 }
 
@@ -32,20 +36,76 @@ type resourceFirewallPortGroup struct {
 	p provider
 }
 
+func portGroupPath(name string) []string {
+	return append(append([]string{}, portGroupsPath...), name)
+}
+
 func (r resourceFirewallPortGroup) Read(ctx context.Context, id string) (interface{}, error) {
-	return r.p.client.Firewall.GetPortGroup(ctx, id)
+	var group types.PortGroup
+	if err := r.p.config.Get(ctx, &group, portGroupPath(id)...); err != nil {
+		return nil, err
+	}
+	group.Name = id
+	return &group, nil
+}
+
+// Normalize drops the ports and port ranges that are not known if
+// `ignore_undeclared_members` is set, and restores the attribute itself.
+func (r resourceFirewallPortGroup) Normalize(known, actual interface{}) interface{} {
+	k, a := known.(types.PortGroup), actual.(*types.PortGroup)
+	if k.IgnoreUndeclared != nil && *k.IgnoreUndeclared {
+		var ports []int
+		for _, port := range a.Ports {
+			for _, p := range k.Ports {
+				if p == port {
+					ports = append(ports, port)
+					break
+				}
+			}
+		}
+
+		var ranges []*types.PortRange
+		for _, r := range a.Ranges {
+			for _, p := range k.Ranges {
+				if *p == *r {
+					ranges = append(ranges, r)
+					break
+				}
+			}
+		}
+		a.Ports, a.Ranges = ports, ranges
+	}
+	a.IgnoreUndeclared = k.IgnoreUndeclared
+	return a
 }
 
 func (r resourceFirewallPortGroup) Create(ctx context.Context, plan interface{}) (interface{}, error) {
 	group := plan.(types.PortGroup)
-	return r.p.client.Firewall.CreatePortGroup(ctx, &group)
+	if err := r.p.config.Set(ctx, group, portGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, group)
 }
 
-func (r resourceFirewallPortGroup) Update(ctx context.Context, current, desired interface{}, patches []jsonpatch.JsonPatchOperation) (interface{}, error) {
-	group := current.(types.PortGroup)
-	return r.p.client.Firewall.UpdatePortGroup(ctx, &group, patches)
+func (r resourceFirewallPortGroup) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	group := desired.(types.PortGroup)
+	if err := r.p.config.Update(ctx, current, group, portGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, group)
 }
 
 func (r resourceFirewallPortGroup) Delete(ctx context.Context, id string) error {
-	return r.p.client.Firewall.DeletePortGroup(ctx, id)
+	if err := groupInUse(ctx, r.p.config, portGroupPath(id), "port group"); err != nil {
+		return err
+	}
+	return r.p.config.Delete(ctx, portGroupPath(id)...)
+}
+
+func (r resourceFirewallPortGroup) read(ctx context.Context, known types.PortGroup) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
 }
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceFirewallPortGroupMemberType struct{}
+
+func (r resourceFirewallPortGroupMemberType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaFirewallPortGroupMember(), nil
+}
+
+func (r resourceFirewallPortGroupMemberType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall port group member",
+			Attribute:    "id",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallPortGroupMember{p: *(p.(*provider))},
+			Type:         types.PortGroupMember{},
+		},
+		p:      *(p.(*provider)),
+		refers: memberReferences("port group", portGroupsPath),
+	}, nil
+}
+
+type resourceFirewallPortGroupMember struct {
+	p provider
+}
+
+func portGroupMembersPath(group string) []string {
+	return append(portGroupPath(group), "port")
+}
+
+// parsePortGroupMemberID splits the id of a port group member into the group
+// and the member, e.g. `web/80` or `web/8000-8100`.
+func parsePortGroupMemberID(id string) (*types.PortGroupMember, error) {
+	group, port, err := parseGroupMemberID(id, "port")
+	if err != nil {
+		return nil, err
+	}
+
+	malformed := fmt.Errorf("The id `%s` is not of the form `<group>/<port>` or `<group>/<from>-<to>`.", id)
+
+	fromTo := strings.SplitN(port, "-", 2)
+	from, err := strconv.Atoi(fromTo[0])
+	if err != nil {
+		return nil, malformed
+	}
+
+	member := &types.PortGroupMember{Group: group, From: from}
+	if len(fromTo) == 2 {
+		to, err := strconv.Atoi(fromTo[1])
+		if err != nil {
+			return nil, malformed
+		}
+		member.To = &to
+	}
+	return member, nil
+}
+
+func (r resourceFirewallPortGroupMember) Read(ctx context.Context, id string) (interface{}, error) {
+	member, err := parsePortGroupMemberID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := readGroupMember(ctx, r.p.config, portGroupMembersPath(member.Group), member.Port()); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (r resourceFirewallPortGroupMember) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	member := plan.(types.PortGroupMember)
+	if err := addGroupMember(ctx, r.p.config, portGroupMembersPath(member.Group), member.Port()); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, member.GetID())
+}
+
+// Update only reads the member back as every attribute forces a replacement.
+func (r resourceFirewallPortGroupMember) Update(ctx context.Context, _, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	member := desired.(types.PortGroupMember)
+	return r.Read(ctx, member.GetID())
+}
+
+func (r resourceFirewallPortGroupMember) Delete(ctx context.Context, id string) error {
+	member, err := parsePortGroupMemberID(id)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Post(ctx, &api.Operation{
+		Delete: api.Nest([]interface{}{member.Port()}, portGroupMembersPath(member.Group)...),
+	})
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceFirewallRuleType struct{}
+
+func (r resourceFirewallRuleType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaFirewallRule(), nil
+}
+
+func (r resourceFirewallRuleType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall rule",
+			Attribute:    "id",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallRule{p: *(p.(*provider))},
+			Type:         types.FirewallRule{},
+		},
+		p:      *(p.(*provider)),
+		refers: firewallRuleReferences,
+	}, nil
+}
+
+type resourceFirewallRule struct {
+	p provider
+}
+
+func firewallRulePath(ruleset string, priority int) []string {
+	return append(rulesetPath(ruleset), "rule", strconv.Itoa(priority))
+}
+
+func parseFirewallRuleID(id string) (string, int, error) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("The id `%s` is not of the form `<ruleset>/<priority>`.", id)
+	}
+
+	priority, err := strconv.Atoi(id[i+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("The id `%s` is not of the form `<ruleset>/<priority>`.", id)
+	}
+	return id[:i], priority, nil
+}
+
+func (r resourceFirewallRule) Read(ctx context.Context, id string) (interface{}, error) {
+	ruleset, priority, err := parseFirewallRuleID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule types.FirewallRule
+	if err := r.p.config.Get(ctx, &rule, firewallRulePath(ruleset, priority)...); err != nil {
+		return nil, err
+	}
+	rule.Ruleset, rule.Priority = ruleset, priority
+	return &rule, nil
+}
+
+// Normalize restores a protocol of `*`, which is never written to the router.
+func (r resourceFirewallRule) Normalize(known, actual interface{}) interface{} {
+	k, a := known.(types.FirewallRule), actual.(*types.FirewallRule)
+	if p := k.Protocol; p != nil && *p == "*" && a.Protocol == nil {
+		a.Protocol = p
+	}
+	utils.Normalize(&k, a)
+	return a
+}
+
+// Create refuses to overwrite a rule that already exists, as it would
+// otherwise be merged with the planned rule.
+func (r resourceFirewallRule) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	rule := plan.(types.FirewallRule)
+	path := firewallRulePath(rule.Ruleset, rule.Priority)
+
+	var existing interface{}
+	if err := r.p.config.Get(ctx, &existing, path...); err == nil {
+		return nil, fmt.Errorf("The ruleset `%s` already has a rule with priority %d.", rule.Ruleset, rule.Priority)
+	} else if !api.IsNotFound(err) {
+		return nil, err
+	}
+
+	if err := r.p.config.Set(ctx, rule, path...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, rule)
+}
+
+func (r resourceFirewallRule) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	rule := desired.(types.FirewallRule)
+	if err := r.p.config.Update(ctx, current, rule, firewallRulePath(rule.Ruleset, rule.Priority)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, rule)
+}
+
+func (r resourceFirewallRule) Delete(ctx context.Context, id string) error {
+	ruleset, priority, err := parseFirewallRuleID(id)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Delete(ctx, firewallRulePath(ruleset, priority)...)
+}
+
+func (r resourceFirewallRule) read(ctx context.Context, known types.FirewallRule) (interface{}, error) {
+	actual, err := r.Read(ctx, known.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
-	"terraform-provider-edge/internal/utils"
-
-	"github.com/frankgreco/edge-sdk-go/types"
 	"github.com/mattbaird/jsonpatch"
 
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -19,12 +24,17 @@ func (r resourceFirewallRulesetType) GetSchema(_ context.Context) (tfsdk.Schema,
 }
 
 func (r resourceFirewallRulesetType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return utils.Resource{
-		Name:         "firewall ruleset",
-		Attribute:    "name",
-		IsConfigured: (p.(*provider)).configured,
-		Api:          resourceFirewallRuleset{p: *(p.(*provider))},
-		Type:         types.Ruleset{},
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall ruleset",
+			Attribute:    "name",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallRuleset{p: *(p.(*provider))},
+			Type:         types.Ruleset{},
+		},
+		p:        *(p.(*provider)),
+		provides: rulesetsPath,
+		refers:   ruleReferences,
 	}, nil
 }
 
@@ -32,68 +42,198 @@ type resourceFirewallRuleset struct {
 	p provider
 }
 
+func rulesetPath(name string) []string {
+	return []string{"firewall", "name", name}
+}
+
 func (r resourceFirewallRuleset) Read(ctx context.Context, id string) (interface{}, error) {
-	return r.p.client.Firewall.GetRuleset(ctx, id)
+	var ruleset types.Ruleset
+	if err := r.p.config.Get(ctx, &ruleset, rulesetPath(id)...); err != nil {
+		return nil, err
+	}
+	ruleset.Name = id
+	return &ruleset, nil
+}
+
+// Normalize lines up the rules by priority before normalizing them and keeps
+// them in the order they are known in. A protocol of `*` is never written to
+// the router so it is restored from what is known, as are `force_detach` and
+// `ignore_undeclared_rules`. If the latter is set, the rules that are not
+// known are dropped.
+func (r resourceFirewallRuleset) Normalize(known, actual interface{}) interface{} {
+	k, a := known.(types.Ruleset), actual.(*types.Ruleset)
+	ignore := k.IgnoreUndeclared != nil && *k.IgnoreUndeclared
+
+	rules, order := map[int]*types.Rule{}, []int{}
+	for _, rule := range k.Rules {
+		rules[rule.Priority] = rule
+		order = append(order, rule.Priority)
+	}
+
+	var declared []*types.Rule
+	for _, rule := range a.Rules {
+		desired, ok := rules[rule.Priority]
+		if !ok {
+			if !ignore {
+				declared = append(declared, rule)
+			}
+			continue
+		}
+		if p := desired.Protocol; p != nil && *p == "*" && rule.Protocol == nil {
+			rule.Protocol = p
+		}
+		utils.Normalize(desired, rule)
+		declared = append(declared, rule)
+	}
+	a.Rules = declared
+
+	less := byKnownOrder(order)
+	sort.SliceStable(a.Rules, func(i, j int) bool {
+		return less(a.Rules[i].Priority, a.Rules[j].Priority)
+	})
+
+	k.Rules = nil
+	utils.Normalize(&k, a)
+	a.ForceDetach, a.IgnoreUndeclared = k.ForceDetach, k.IgnoreUndeclared
+	return a
+}
+
+// byKnownOrder returns a function that orders priorities as the rules are
+// ordered in known, followed by the priorities that are not known in
+// ascending order. The router reads the rules back by ascending priority, but
+// they may be declared in any order.
+func byKnownOrder(known []int) func(a, b int) bool {
+	index := map[int]int{}
+	for i, priority := range known {
+		index[priority] = i
+	}
+	return func(a, b int) bool {
+		i, aok := index[a]
+		j, bok := index[b]
+		if aok && bok {
+			return i < j
+		}
+		if aok != bok {
+			return aok
+		}
+		return a < b
+	}
 }
 
 func (r resourceFirewallRuleset) Create(ctx context.Context, plan interface{}) (interface{}, error) {
-	desiredRuleset := plan.(types.Ruleset)
+	ruleset := plan.(types.Ruleset)
+	if err := r.p.config.Set(ctx, ruleset, rulesetPath(ruleset.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, ruleset)
+}
+
+func (r resourceFirewallRuleset) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	ruleset := desired.(types.Ruleset)
 
-	createdRuleset, err := r.p.client.Firewall.CreateRuleset(ctx, &desiredRuleset)
+	op, err := rulesetOperation(current.(types.Ruleset), ruleset)
 	if err != nil {
 		return nil, err
 	}
-
-	return normalize(&desiredRuleset, createdRuleset), nil
+	if !op.IsEmpty() {
+		if err := r.p.config.Post(ctx, op); err != nil {
+			return nil, err
+		}
+	}
+	return r.read(ctx, ruleset)
 }
 
-func (r resourceFirewallRuleset) Update(ctx context.Context, current, desired interface{}, patches []jsonpatch.JsonPatchOperation) (interface{}, error) {
-	currentRuleset := current.(types.Ruleset)
-	desiredRuleset := desired.(types.Ruleset)
+// rulesetOperation returns the operation that transforms current into
+// desired. Only the rules that changed are touched.
+func rulesetOperation(current, desired types.Ruleset) (*api.Operation, error) {
+	path := rulesetPath(desired.Name)
 
-	updatedRuleset, err := r.p.client.Firewall.UpdateRuleset(ctx, &currentRuleset, patches)
+	c, d := map[string]interface{}{}, map[string]interface{}{}
+	for _, rule := range current.Rules {
+		c[strconv.Itoa(rule.Priority)] = rule
+	}
+	for _, rule := range desired.Rules {
+		d[strconv.Itoa(rule.Priority)] = rule
+	}
+
+	current.Rules, desired.Rules = nil, nil
+	op, err := api.NewUpdate(current, desired, path...)
 	if err != nil {
 		return nil, err
 	}
 
-	return normalize(&desiredRuleset, updatedRuleset), nil
+	rules, err := rulesOperation(append(path, "rule"), c, d)
+	if err != nil {
+		return nil, err
+	}
+	return op.Merge(rules), nil
 }
 
-func (r resourceFirewallRuleset) Delete(ctx context.Context, id string) error {
-	return r.p.client.Firewall.DeleteRuleset(ctx, id)
-}
+// rulesOperation returns the operation that transforms the rules at path from
+// current into desired, both keyed by priority. Rules that are equal in both
+// are not touched.
+func rulesOperation(path []string, current, desired map[string]interface{}) (*api.Operation, error) {
+	op := new(api.Operation)
 
-// We need to tell the difference between null and false for certain booleans.
-// If we don't, we'll get errors of the following type:
-//
-//	providerproduced an unexpected new value: .default_logging: was null, but now cty.False.
-//
-// To fix this, if we get a null or false value back and the desired state was either
-// null or false, we're going to set the state to whatever was in the plan.
-func normalize(desired, actual *types.Ruleset) *types.Ruleset {
-	// ruleset.default_logging
-	if l := actual.DefaultLogging; l == nil || !*l {
-		actual.DefaultLogging = desired.DefaultLogging
-	}
-
-	// ruleset.rules[*].log
-	desiredIndexed := indexPriority(desired.Rules)
-
-	for _, rule := range actual.Rules {
-		if l := rule.Log; l == nil || !*l {
-			rule.Log = desired.Rules[desiredIndexed[rule.Priority]].Log
+	for priority := range current {
+		if _, ok := desired[priority]; !ok {
+			op.Merge(api.NewDelete(append(append([]string{}, path...), priority)...))
 		}
 	}
 
-	return actual
+	for priority, rule := range desired {
+		update, err := api.NewUpdate(current[priority], rule, append(append([]string{}, path...), priority)...)
+		if err != nil {
+			return nil, err
+		}
+		op.Merge(update)
+	}
+
+	return op, nil
+}
+
+func (r resourceFirewallRuleset) Delete(ctx context.Context, id string) error {
+	return r.delete(ctx, id, false)
+}
+
+// Finalize deletes the ruleset, first detaching it from every interface if
+// `force_detach` is set.
+func (r resourceFirewallRuleset) Finalize(ctx context.Context, known interface{}) error {
+	ruleset := known.(types.Ruleset)
+	return r.delete(ctx, ruleset.Name, ruleset.ForceDetach != nil && *ruleset.ForceDetach)
 }
 
-func indexPriority(rules []*types.Rule) map[int]int {
-	m := map[int]int{}
+func (r resourceFirewallRuleset) delete(ctx context.Context, name string, detach bool) error {
+	var interfaces interface{}
+	if err := r.p.config.Get(ctx, &interfaces, "interfaces"); err != nil && !api.IsNotFound(err) {
+		return err
+	}
+
+	attachments := rulesetAttachments(interfaces, name)
+	if len(attachments) == 0 {
+		return r.p.config.Delete(ctx, rulesetPath(name)...)
+	}
+
+	if !detach {
+		attached := []string{}
+		for _, a := range attachments {
+			attached = append(attached, fmt.Sprintf("%s (%s)", a.iface, a.direction))
+		}
+		return fmt.Errorf("The ruleset `%s` is still attached to %s. Detach it first or set `force_detach`.", name, strings.Join(attached, ", "))
+	}
 
-	for i, rule := range rules {
-		m[rule.Priority] = i
+	op := new(api.Operation)
+	for _, a := range attachments {
+		op.Merge(api.NewDelete(a.path...))
 	}
+	op.Merge(api.NewDelete(rulesetPath(name)...))
+	return r.p.config.Post(ctx, op)
+}
 
-	return m
+func (r resourceFirewallRuleset) read(ctx context.Context, known types.Ruleset) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
 }
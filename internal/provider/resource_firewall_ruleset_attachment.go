@@ -2,10 +2,11 @@ package provider
 
 import (
 	"context"
+	"strings"
 
-	"github.com/frankgreco/edge-sdk-go/types"
 	"github.com/mattbaird/jsonpatch"
 
+	"terraform-provider-edge/internal/types"
 	"terraform-provider-edge/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,12 +20,16 @@ func (r resourceFirewallRulesetAttachmentType) GetSchema(_ context.Context) (tfs
 }
 
 func (r resourceFirewallRulesetAttachmentType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return utils.Resource{
-		Name:         "firewall ruleset attachment",
-		Attribute:    "interface",
-		IsConfigured: (p.(*provider)).configured,
-		Api:          resourceFirewallRulesetAttachment{p: *(p.(*provider))},
-		Type:         types.FirewallAttachment{},
+	return resourceWithReferences{
+		Resource: utils.Resource{
+			Name:         "firewall ruleset attachment",
+			Attribute:    "interface",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceFirewallRulesetAttachment{p: *(p.(*provider))},
+			Type:         types.FirewallAttachment{},
+		},
+		p:      *(p.(*provider)),
+		refers: attachmentReferences,
 	}, nil
 }
 
@@ -32,20 +37,58 @@ type resourceFirewallRulesetAttachment struct {
 	p provider
 }
 
+// interfacePath returns the configuration node of an interface, e.g.
+// `interfaces switch switch0 vif 10` for `switch0.10`.
+func interfacePath(iface string) []string {
+	name, vif := iface, ""
+	if i := strings.Index(iface, "."); i >= 0 {
+		name, vif = iface[:i], iface[i+1:]
+	}
+
+	kind := "ethernet"
+	switch {
+	case strings.HasPrefix(name, "br"):
+		kind = "bridge"
+	case strings.HasPrefix(name, "switch"):
+		kind = "switch"
+	}
+
+	path := []string{"interfaces", kind, name}
+	if vif != "" {
+		path = append(path, "vif", vif)
+	}
+	return path
+}
+
+func firewallAttachmentPath(iface string) []string {
+	return append(interfacePath(iface), "firewall")
+}
+
 func (r resourceFirewallRulesetAttachment) Read(ctx context.Context, id string) (interface{}, error) {
-	return r.p.client.Interfaces.Ethernet.GetFirewallRulesetAttachment(ctx, id)
+	var attachment types.FirewallAttachment
+	if err := r.p.config.Get(ctx, &attachment, firewallAttachmentPath(id)...); err != nil {
+		return nil, err
+	}
+	attachment.Interface = id
+	return &attachment, nil
 }
 
 func (r resourceFirewallRulesetAttachment) Create(ctx context.Context, plan interface{}) (interface{}, error) {
 	attachment := plan.(types.FirewallAttachment)
-	return r.p.client.Interfaces.Ethernet.AttachFirewallRuleset(ctx, attachment.Interface, &attachment)
+	if err := r.p.config.Set(ctx, attachment, firewallAttachmentPath(attachment.Interface)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, attachment.Interface)
 }
 
-func (r resourceFirewallRulesetAttachment) Update(ctx context.Context, current, desired interface{}, patches []jsonpatch.JsonPatchOperation) (interface{}, error) {
-	attachment := current.(types.FirewallAttachment)
-	return r.p.client.Interfaces.Ethernet.UpdateFirewallRulesetAttachment(ctx, &attachment, patches)
+func (r resourceFirewallRulesetAttachment) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	attachment := desired.(types.FirewallAttachment)
+	if err := r.p.config.Update(ctx, current, attachment, firewallAttachmentPath(attachment.Interface)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, attachment.Interface)
 }
 
 func (r resourceFirewallRulesetAttachment) Delete(ctx context.Context, id string) error {
-	return r.p.client.Interfaces.Ethernet.DetachFirewallRuleset(ctx, id)
+	return r.p.config.Delete(ctx, firewallAttachmentPath(id)...)
 }
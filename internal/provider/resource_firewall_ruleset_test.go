@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"terraform-provider-edge/internal/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRulesetOperation(t *testing.T) {
+	tcp, udp, description := "tcp", "udp", "lan"
+	ruleset := func(description *string, rules ...*types.Rule) types.Ruleset {
+		return types.Ruleset{
+			Name:          "LAN_IN",
+			Description:   description,
+			DefaultAction: "accept",
+			Rules:         rules,
+		}
+	}
+	rule := func(priority int, protocol *string) *types.Rule {
+		return &types.Rule{
+			Priority: priority,
+			Action:   "drop",
+			Protocol: protocol,
+		}
+	}
+
+	for _, test := range []struct {
+		name             string
+		current, desired types.Ruleset
+		expected         string
+	}{
+		{
+			name:     "unchanged",
+			current:  ruleset(nil, rule(10, &tcp), rule(20, &tcp)),
+			desired:  ruleset(nil, rule(10, &tcp), rule(20, &tcp)),
+			expected: `{}`,
+		},
+		{
+			name:     "one rule changed",
+			current:  ruleset(nil, rule(10, &tcp), rule(20, &tcp)),
+			desired:  ruleset(nil, rule(10, &tcp), rule(20, &udp)),
+			expected: `{"SET":{"firewall":{"name":{"LAN_IN":{"rule":{"20":{"action":"drop","protocol":"udp"}}}}}}}`,
+		},
+		{
+			name:     "one rule removed",
+			current:  ruleset(nil, rule(10, &tcp), rule(20, &tcp)),
+			desired:  ruleset(nil, rule(20, &tcp)),
+			expected: `{"DELETE":{"firewall":{"name":{"LAN_IN":{"rule":{"10":null}}}}}}`,
+		},
+		{
+			name:     "attribute of a rule removed",
+			current:  ruleset(nil, rule(10, &tcp), rule(20, &tcp)),
+			desired:  ruleset(nil, rule(10, &tcp), rule(20, nil)),
+			expected: `{"SET":{"firewall":{"name":{"LAN_IN":{"rule":{"20":{"action":"drop"}}}}}},"DELETE":{"firewall":{"name":{"LAN_IN":{"rule":{"20":{"protocol":null}}}}}}}`,
+		},
+		{
+			name:     "description added",
+			current:  ruleset(nil, rule(10, &tcp)),
+			desired:  ruleset(&description, rule(10, &tcp)),
+			expected: `{"SET":{"firewall":{"name":{"LAN_IN":{"default-action":"accept","description":"lan"}}}}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			op, err := rulesetOperation(test.current, test.desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := json.Marshal(op)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, string(data))
+			}
+		})
+	}
+}
+
+func TestRulesetNormalizeUndeclaredRules(t *testing.T) {
+	tcp, ignore := "tcp", true
+	rule := func(priority int) *types.Rule {
+		return &types.Rule{
+			Priority: priority,
+			Action:   "drop",
+			Protocol: &tcp,
+		}
+	}
+
+	for _, test := range []struct {
+		name     string
+		ignore   *bool
+		known    []int
+		expected []int
+	}{
+		{name: "kept", ignore: nil, known: []int{10, 30}, expected: []int{10, 30, 20}},
+		{name: "ignored", ignore: &ignore, known: []int{10, 30}, expected: []int{10, 30}},
+		{name: "declared out of order", ignore: &ignore, known: []int{30, 10}, expected: []int{30, 10}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var rules []*types.Rule
+			for _, priority := range test.known {
+				rules = append(rules, rule(priority))
+			}
+			known := types.Ruleset{
+				Name:             "LAN_IN",
+				DefaultAction:    "accept",
+				Rules:            rules,
+				IgnoreUndeclared: test.ignore,
+			}
+			actual := &types.Ruleset{
+				Name:          "LAN_IN",
+				DefaultAction: "accept",
+				Rules:         []*types.Rule{rule(10), rule(20), rule(30)},
+			}
+
+			normalized := resourceFirewallRuleset{}.Normalize(known, actual).(*types.Ruleset)
+
+			priorities := []int{}
+			for _, rule := range normalized.Rules {
+				priorities = append(priorities, rule.Priority)
+			}
+			if !reflect.DeepEqual(priorities, test.expected) {
+				t.Errorf("expected rules %v, got %v", test.expected, priorities)
+			}
+			if normalized.IgnoreUndeclared != test.ignore {
+				t.Errorf("expected ignore_undeclared_rules to be restored")
+			}
+		})
+	}
+}
+
+func TestParseFirewallRuleID(t *testing.T) {
+	ruleset, priority, err := parseFirewallRuleID("LAN_IN/10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ruleset != "LAN_IN" || priority != 10 {
+		t.Errorf("expected LAN_IN/10, got %s/%d", ruleset, priority)
+	}
+
+	for _, id := range []string{"LAN_IN", "/10", "LAN_IN/", "LAN_IN/ten"} {
+		if _, _, err := parseFirewallRuleID(id); err == nil {
+			t.Errorf("expected an error for %s", id)
+		}
+	}
+}
+
+func TestRulesetStateUpgrade(t *testing.T) {
+	ctx := context.Background()
+
+	// Version 0 of the schema declared the rules as a set.
+	v0 := schemaFirewallRuleset()
+	v0.Version = 0
+	rule := v0.Blocks["rule"]
+	rule.NestingMode = tfsdk.BlockNestingModeSet
+	v0.Blocks["rule"] = rule
+
+	var state interface{}
+	if err := json.Unmarshal([]byte(`{"id":"LAN_IN","name":"LAN_IN","default_action":"drop","rule":[{"priority":20,"action":"drop"},{"priority":10,"action":"accept"}]}`), &state); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(stateJSON(v0.TerraformType(ctx), state))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The framework passes the state of an older version through unchanged.
+	v1 := schemaFirewallRuleset()
+	raw, err := (&tfprotov6.DynamicValue{JSON: data}).Unmarshal(v1.TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ruleset types.Ruleset
+	if diags := (tfsdk.State{Schema: v1, Raw: raw}).Get(ctx, &ruleset); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(ruleset.Rules) != 2 || ruleset.Rules[0].Priority != 20 || ruleset.Rules[1].Priority != 10 {
+		t.Errorf("expected the rules of the old state, got %+v", ruleset.Rules)
+	}
+}
+
+// stateJSON fills in null for every attribute of typ that v does not set, as
+// terraform does when it stores a state.
+func stateJSON(typ tftypes.Type, v interface{}) interface{} {
+	var elem tftypes.Type
+	switch t := typ.(type) {
+	case tftypes.Object:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		object := map[string]interface{}{}
+		for k, attr := range t.AttributeTypes {
+			object[k] = stateJSON(attr, m[k])
+		}
+		return object
+	case tftypes.List:
+		elem = t.ElementType
+	case tftypes.Set:
+		elem = t.ElementType
+	default:
+		return v
+	}
+
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	elems := []interface{}{}
+	for _, e := range l {
+		elems = append(elems, stateJSON(elem, e))
+	}
+	return elems
+}
+
+func TestFirewallMatchAttributes(t *testing.T) {
+	// The attributes a ruleset rule had before the match attributes were
+	// extracted for the traffic shaper.
+	rule := firewallRuleAttributes()
+	expected := []string{"description", "destination", "log", "protocol", "source", "state"}
+	var actual []string
+	for name := range rule {
+		actual = append(actual, name)
+	}
+	sort.Strings(actual)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected the rule attributes %v, got %v", expected, actual)
+	}
+
+	ruleset := schemaFirewallRuleset().Blocks["rule"].Attributes
+	classes := schemaTrafficPolicyShaper().Attributes["classes"].Attributes.GetAttributes()
+	match := classes["match"].Attributes.GetAttributes()
+	for name, attribute := range firewallMatchAttributes() {
+		if !rule[name].Equal(attribute) {
+			t.Errorf("expected the rule attribute %s to be the shared match attribute", name)
+		}
+		if !ruleset[name].Equal(attribute) {
+			t.Errorf("expected the ruleset attribute %s to be the shared match attribute", name)
+		}
+		if !match[name].Equal(attribute) {
+			t.Errorf("expected the shaper match attribute %s to be the shared match attribute", name)
+		}
+	}
+}
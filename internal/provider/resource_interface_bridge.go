@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var ethernetPath = []string{"interfaces", "ethernet"}
+
+type resourceInterfaceBridgeType struct{}
+
+func (r resourceInterfaceBridgeType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaInterfaceBridge(), nil
+}
+
+func (r resourceInterfaceBridgeType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "bridge interface",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceInterfaceBridge{p: *(p.(*provider))},
+		Type:         types.Bridge{},
+	}, nil
+}
+
+type resourceInterfaceBridge struct {
+	p provider
+}
+
+func bridgePath(name string) []string {
+	return []string{"interfaces", "bridge", name}
+}
+
+func (r resourceInterfaceBridge) Read(ctx context.Context, id string) (interface{}, error) {
+	var bridge types.Bridge
+	if err := r.p.config.Get(ctx, &bridge, bridgePath(id)...); err != nil {
+		return nil, err
+	}
+	bridge.Name = id
+
+	members, err := r.members(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	bridge.Members = members
+	return &bridge, nil
+}
+
+func (r resourceInterfaceBridge) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.Bridge)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+func (r resourceInterfaceBridge) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	bridge := plan.(types.Bridge)
+
+	op, err := api.NewSet(bridge, bridgePath(bridge.Name)...)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := bridgeMemberOperation(bridge.Name, nil, bridge.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.p.config.Post(ctx, op.Merge(members)); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, bridge)
+}
+
+func (r resourceInterfaceBridge) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	c, d := current.(types.Bridge), desired.(types.Bridge)
+
+	op, err := api.NewUpdate(c, d, bridgePath(d.Name)...)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := bridgeMemberOperation(d.Name, c.Members, d.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	if op = op.Merge(members); !op.IsEmpty() {
+		if err := r.p.config.Post(ctx, op); err != nil {
+			return nil, err
+		}
+	}
+	return r.read(ctx, d)
+}
+
+// Delete removes the members from the bridge along with the bridge itself, as
+// the router refuses to delete a bridge that still has members.
+func (r resourceInterfaceBridge) Delete(ctx context.Context, id string) error {
+	current, err := r.members(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	members, err := bridgeMemberOperation(id, current, nil)
+	if err != nil {
+		return err
+	}
+
+	return r.p.config.Post(ctx, api.NewDelete(bridgePath(id)...).Merge(members))
+}
+
+func (r resourceInterfaceBridge) read(ctx context.Context, known types.Bridge) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
+
+// members returns the ethernet interfaces whose bridge group is the bridge.
+func (r resourceInterfaceBridge) members(ctx context.Context, name string) ([]string, error) {
+	var ethernets map[string]*struct {
+		BridgeGroup *types.BridgeGroup `json:"bridge-group"`
+	}
+	if err := r.p.config.Get(ctx, &ethernets, ethernetPath...); err != nil {
+		return nil, err
+	}
+
+	var members []string
+	for iface, ethernet := range ethernets {
+		if ethernet != nil && ethernet.BridgeGroup != nil && ethernet.BridgeGroup.Bridge == name {
+			members = append(members, iface)
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+// bridgeMemberOperation returns the operation that moves the bridge group of
+// the interfaces from current to desired.
+func bridgeMemberOperation(name string, current, desired []string) (*api.Operation, error) {
+	op := new(api.Operation)
+
+	for _, iface := range current {
+		if !contains(desired, iface) {
+			op.Merge(api.NewDelete(append(ethernetPath, iface, "bridge-group")...))
+		}
+	}
+
+	for _, iface := range desired {
+		if contains(current, iface) {
+			continue
+		}
+
+		set, err := api.NewSet(types.BridgeGroup{Bridge: name}, append(ethernetPath, iface, "bridge-group")...)
+		if err != nil {
+			return nil, err
+		}
+		op.Merge(set)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestBridgeMemberOperation(t *testing.T) {
+	for _, test := range []struct {
+		name             string
+		current, desired []string
+		expected         string
+	}{
+		{
+			name:     "unchanged",
+			current:  []string{"eth1", "eth2"},
+			desired:  []string{"eth1", "eth2"},
+			expected: `{}`,
+		},
+		{
+			name:     "added",
+			desired:  []string{"eth1"},
+			expected: `{"SET":{"interfaces":{"ethernet":{"eth1":{"bridge-group":{"bridge":"br0"}}}}}}`,
+		},
+		{
+			name:     "replaced",
+			current:  []string{"eth1"},
+			desired:  []string{"eth2"},
+			expected: `{"SET":{"interfaces":{"ethernet":{"eth2":{"bridge-group":{"bridge":"br0"}}}}},"DELETE":{"interfaces":{"ethernet":{"eth1":{"bridge-group":null}}}}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			op, err := bridgeMemberOperation("br0", test.current, test.desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := json.Marshal(op)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, string(data))
+			}
+		})
+	}
+}
+
+func TestInterfacePath(t *testing.T) {
+	for iface, expected := range map[string][]string{
+		"eth0":       {"interfaces", "ethernet", "eth0"},
+		"eth1.20":    {"interfaces", "ethernet", "eth1", "vif", "20"},
+		"br0":        {"interfaces", "bridge", "br0"},
+		"switch0":    {"interfaces", "switch", "switch0"},
+		"switch0.10": {"interfaces", "switch", "switch0", "vif", "10"},
+	} {
+		if actual := interfacePath(iface); !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: expected %v, got %v", iface, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceInterfaceSwitchType struct{}
+
+func (r resourceInterfaceSwitchType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaInterfaceSwitch(), nil
+}
+
+func (r resourceInterfaceSwitchType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "switch interface",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceInterfaceSwitch{p: *(p.(*provider))},
+		Type:         types.Switch{},
+	}, nil
+}
+
+type resourceInterfaceSwitch struct {
+	p provider
+}
+
+func switchPath(name string) []string {
+	return []string{"interfaces", "switch", name}
+}
+
+func (r resourceInterfaceSwitch) Read(ctx context.Context, id string) (interface{}, error) {
+	var sw types.Switch
+	if err := r.p.config.Get(ctx, &sw, switchPath(id)...); err != nil {
+		return nil, err
+	}
+	sw.Name = id
+	return &sw, nil
+}
+
+func (r resourceInterfaceSwitch) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.Switch)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+func (r resourceInterfaceSwitch) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	sw := plan.(types.Switch)
+	if err := r.p.config.Set(ctx, sw, switchPath(sw.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, sw)
+}
+
+func (r resourceInterfaceSwitch) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	sw := desired.(types.Switch)
+	if err := r.p.config.Update(ctx, current, sw, switchPath(sw.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, sw)
+}
+
+func (r resourceInterfaceSwitch) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, switchPath(id)...)
+}
+
+func (r resourceInterfaceSwitch) read(ctx context.Context, known types.Switch) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
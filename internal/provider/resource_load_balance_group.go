@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceLoadBalanceGroupType struct{}
+
+func (r resourceLoadBalanceGroupType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaLoadBalanceGroup(), nil
+}
+
+func (r resourceLoadBalanceGroupType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "load balance group",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceLoadBalanceGroup{p: *(p.(*provider))},
+		Type:         types.LoadBalanceGroup{},
+	}, nil
+}
+
+type resourceLoadBalanceGroup struct {
+	p provider
+}
+
+func loadBalanceGroupPath(name string) []string {
+	return []string{"load-balance", "group", name}
+}
+
+func (r resourceLoadBalanceGroup) Read(ctx context.Context, id string) (interface{}, error) {
+	var group types.LoadBalanceGroup
+	if err := r.p.config.Get(ctx, &group, loadBalanceGroupPath(id)...); err != nil {
+		return nil, err
+	}
+	group.Name = id
+	return &group, nil
+}
+
+func (r resourceLoadBalanceGroup) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.LoadBalanceGroup)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+func (r resourceLoadBalanceGroup) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	group := plan.(types.LoadBalanceGroup)
+	if err := r.p.config.Set(ctx, group, loadBalanceGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, group)
+}
+
+func (r resourceLoadBalanceGroup) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	group := desired.(types.LoadBalanceGroup)
+	if err := r.p.config.Update(ctx, current, group, loadBalanceGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, group)
+}
+
+func (r resourceLoadBalanceGroup) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, loadBalanceGroupPath(id)...)
+}
+
+func (r resourceLoadBalanceGroup) read(ctx context.Context, known types.LoadBalanceGroup) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourcePrefixListType struct{}
+
+func (r resourcePrefixListType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaPrefixList(), nil
+}
+
+func (r resourcePrefixListType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "prefix list",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourcePrefixList{p: *(p.(*provider))},
+		Type:         types.PrefixList{},
+	}, nil
+}
+
+type resourcePrefixList struct {
+	p provider
+}
+
+func prefixListPath(name string) []string {
+	return []string{"policy", "prefix-list", name}
+}
+
+func (r resourcePrefixList) Read(ctx context.Context, id string) (interface{}, error) {
+	var list types.PrefixList
+	if err := r.p.config.Get(ctx, &list, prefixListPath(id)...); err != nil {
+		return nil, err
+	}
+	list.Name = id
+	return &list, nil
+}
+
+func (r resourcePrefixList) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	list := plan.(types.PrefixList)
+	if err := r.p.config.Set(ctx, list, prefixListPath(list.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, list.Name)
+}
+
+func (r resourcePrefixList) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	list := desired.(types.PrefixList)
+	if err := r.p.config.Update(ctx, current, list, prefixListPath(list.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, list.Name)
+}
+
+func (r resourcePrefixList) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, prefixListPath(id)...)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceProtocolsBGPType struct{}
+
+func (r resourceProtocolsBGPType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaProtocolsBGP(), nil
+}
+
+func (r resourceProtocolsBGPType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "bgp process",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceProtocolsBGP{p: *(p.(*provider))},
+		Type:         types.BGP{},
+	}, nil
+}
+
+type resourceProtocolsBGP struct {
+	p provider
+}
+
+func bgpPath(asn string) []string {
+	return []string{"protocols", "bgp", asn}
+}
+
+func (r resourceProtocolsBGP) Read(ctx context.Context, id string) (interface{}, error) {
+	asn, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("The AS number `%s` is not a number.", id)
+	}
+
+	var bgp types.BGP
+	if err := r.p.config.Get(ctx, &bgp, bgpPath(id)...); err != nil {
+		return nil, err
+	}
+	bgp.ASN = asn
+	return &bgp, nil
+}
+
+func (r resourceProtocolsBGP) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	bgp := plan.(types.BGP)
+	if err := r.p.config.Set(ctx, bgp, bgpPath(bgp.GetID())...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, bgp.GetID())
+}
+
+// Update only touches the settings of the process. The neighbors are not part
+// of types.BGP and are therefore left alone.
+func (r resourceProtocolsBGP) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	bgp := desired.(types.BGP)
+	if err := r.p.config.Update(ctx, current, bgp, bgpPath(bgp.GetID())...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, bgp.GetID())
+}
+
+func (r resourceProtocolsBGP) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, bgpPath(id)...)
+}
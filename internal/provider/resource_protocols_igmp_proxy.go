@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var igmpProxyPath = []string{"protocols", "igmp-proxy"}
+
+type resourceProtocolsIGMPProxyType struct{}
+
+func (r resourceProtocolsIGMPProxyType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaProtocolsIGMPProxy(), nil
+}
+
+func (r resourceProtocolsIGMPProxyType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "igmp proxy",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceProtocolsIGMPProxy{p: *(p.(*provider))},
+		Type:         types.IGMPProxy{},
+	}, nil
+}
+
+type resourceProtocolsIGMPProxy struct {
+	p provider
+}
+
+func (r resourceProtocolsIGMPProxy) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.IGMPProxyID {
+		return nil, fmt.Errorf("The igmp proxy is identified by `%s`.", types.IGMPProxyID)
+	}
+
+	var proxy types.IGMPProxy
+	if err := r.p.config.Get(ctx, &proxy, igmpProxyPath...); err != nil {
+		return nil, err
+	}
+	return &proxy, nil
+}
+
+func (r resourceProtocolsIGMPProxy) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	if err := r.p.config.Set(ctx, plan, igmpProxyPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.IGMPProxyID)
+}
+
+func (r resourceProtocolsIGMPProxy) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, igmpProxyPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.IGMPProxyID)
+}
+
+func (r resourceProtocolsIGMPProxy) Delete(ctx context.Context, _ string) error {
+	return r.p.config.Delete(ctx, igmpProxyPath...)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var ospfPath = []string{"protocols", "ospf"}
+
+type resourceProtocolsOSPFType struct{}
+
+func (r resourceProtocolsOSPFType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaProtocolsOSPF(), nil
+}
+
+func (r resourceProtocolsOSPFType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "ospf process",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceProtocolsOSPF{p: *(p.(*provider))},
+		Type:         types.OSPF{},
+	}, nil
+}
+
+type resourceProtocolsOSPF struct {
+	p provider
+}
+
+func (r resourceProtocolsOSPF) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.OSPFID {
+		return nil, fmt.Errorf("The ospf process is identified by `%s`.", types.OSPFID)
+	}
+
+	var ospf types.OSPF
+	if err := r.p.config.Get(ctx, &ospf, ospfPath...); err != nil {
+		return nil, err
+	}
+	return &ospf, nil
+}
+
+func (r resourceProtocolsOSPF) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.OSPF)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+func (r resourceProtocolsOSPF) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	if err := r.p.config.Set(ctx, plan, ospfPath...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, plan.(types.OSPF))
+}
+
+func (r resourceProtocolsOSPF) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, ospfPath...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, desired.(types.OSPF))
+}
+
+func (r resourceProtocolsOSPF) Delete(ctx context.Context, _ string) error {
+	return r.p.config.Delete(ctx, ospfPath...)
+}
+
+func (r resourceProtocolsOSPF) read(ctx context.Context, known types.OSPF) (interface{}, error) {
+	actual, err := r.Read(ctx, types.OSPFID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
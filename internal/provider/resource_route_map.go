@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceRouteMapType struct{}
+
+func (r resourceRouteMapType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaRouteMap(), nil
+}
+
+func (r resourceRouteMapType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "route map",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceRouteMap{p: *(p.(*provider))},
+		Type:         types.RouteMap{},
+	}, nil
+}
+
+type resourceRouteMap struct {
+	p provider
+}
+
+func routeMapPath(name string) []string {
+	return []string{"policy", "route-map", name}
+}
+
+func (r resourceRouteMap) Read(ctx context.Context, id string) (interface{}, error) {
+	var routeMap types.RouteMap
+	if err := r.p.config.Get(ctx, &routeMap, routeMapPath(id)...); err != nil {
+		return nil, err
+	}
+	routeMap.Name = id
+	return &routeMap, nil
+}
+
+func (r resourceRouteMap) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	routeMap := plan.(types.RouteMap)
+	if err := r.p.config.Set(ctx, routeMap, routeMapPath(routeMap.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, routeMap.Name)
+}
+
+func (r resourceRouteMap) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	routeMap := desired.(types.RouteMap)
+	if err := r.p.config.Update(ctx, current, routeMap, routeMapPath(routeMap.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, routeMap.Name)
+}
+
+func (r resourceRouteMap) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, routeMapPath(id)...)
+}
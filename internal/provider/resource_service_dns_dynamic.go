@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceServiceDNSDynamicType struct{}
+
+func (r resourceServiceDNSDynamicType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaServiceDNSDynamic(), nil
+}
+
+func (r resourceServiceDNSDynamicType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "dynamic dns service",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceServiceDNSDynamic{p: *(p.(*provider))},
+		Type:         types.DynamicDNS{},
+	}, nil
+}
+
+type resourceServiceDNSDynamic struct {
+	p provider
+}
+
+func dynamicDNSPath(iface, service string) []string {
+	return []string{"service", "dns", "dynamic", "interface", iface, "service", service}
+}
+
+func parseDynamicDNSID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("The id `%s` is not of the form `<interface>/<service>`.", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (r resourceServiceDNSDynamic) Read(ctx context.Context, id string) (interface{}, error) {
+	iface, service, err := parseDynamicDNSID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var ddns types.DynamicDNS
+	if err := r.p.config.Get(ctx, &ddns, dynamicDNSPath(iface, service)...); err != nil {
+		return nil, err
+	}
+	ddns.Interface, ddns.Service = iface, service
+	return &ddns, nil
+}
+
+func (r resourceServiceDNSDynamic) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	ddns := plan.(types.DynamicDNS)
+	if err := r.p.config.Set(ctx, ddns, dynamicDNSPath(ddns.Interface, ddns.Service)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, ddns.GetID())
+}
+
+func (r resourceServiceDNSDynamic) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	ddns := desired.(types.DynamicDNS)
+	if err := r.p.config.Update(ctx, current, ddns, dynamicDNSPath(ddns.Interface, ddns.Service)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, ddns.GetID())
+}
+
+func (r resourceServiceDNSDynamic) Delete(ctx context.Context, id string) error {
+	iface, service, err := parseDynamicDNSID(id)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Delete(ctx, dynamicDNSPath(iface, service)...)
+}
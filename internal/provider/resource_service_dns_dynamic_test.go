@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseDynamicDNSID(t *testing.T) {
+	iface, service, err := parseDynamicDNSID("eth0.10/custom-cloudflare")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iface != "eth0.10" || service != "custom-cloudflare" {
+		t.Errorf("expected eth0.10/custom-cloudflare, got %s/%s", iface, service)
+	}
+
+	for _, id := range []string{"eth0", "/dyndns", "eth0/", ""} {
+		if _, _, err := parseDynamicDNSID(id); err == nil {
+			t.Errorf("expected an error for %s", id)
+		}
+	}
+}
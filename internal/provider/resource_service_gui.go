@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var guiPath = []string{"service", "gui"}
+
+// guiDefaults are the factory defaults of the web interface.
+var guiDefaults = types.GUI{
+	HTTPPort:     intptr(80),
+	HTTPSPort:    intptr(443),
+	OlderCiphers: togglePtr(true),
+}
+
+type resourceServiceGUIType struct{}
+
+func (r resourceServiceGUIType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaServiceGUI(), nil
+}
+
+func (r resourceServiceGUIType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceServiceGUIWithPlan{
+		Resource: utils.Resource{
+			Name:         "web interface",
+			Attribute:    "id",
+			IsConfigured: (p.(*provider)).configured,
+			Api:          resourceServiceGUI{p: *(p.(*provider))},
+			Type:         types.GUI{},
+		},
+		host: (p.(*provider)).host,
+	}, nil
+}
+
+// resourceServiceGUIWithPlan warns about changes that would break the
+// connection of the provider itself.
+type resourceServiceGUIWithPlan struct {
+	utils.Resource
+	host string
+}
+
+func (r resourceServiceGUIWithPlan) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if r.host == "" || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Values that are not known yet cannot be checked.
+	var desired types.GUI
+	if diags := req.Plan.Get(ctx, &desired); diags.HasError() {
+		return
+	}
+
+	current := guiDefaults
+	if !req.State.Raw.IsNull() {
+		current = types.GUI{}
+		if diags := req.State.Get(ctx, &current); diags.HasError() {
+			return
+		}
+	}
+
+	for _, warning := range guiConnectionWarnings(r.host, current, desired) {
+		resp.Diagnostics.AddWarning("This change affects the connection of the provider.", warning)
+	}
+}
+
+// guiConnectionWarnings explains how going from current to desired would
+// break the connection to host.
+func guiConnectionWarnings(host string, current, desired types.GUI) []string {
+	u, err := url.Parse(host)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+
+	attribute, from, to := "https_port", current.HTTPSPort, desired.HTTPSPort
+	if u.Scheme == "http" {
+		attribute, from, to = "http_port", current.HTTPPort, desired.HTTPPort
+	}
+
+	port := guiPort(u.Port(), from, u.Scheme)
+	warnings := []string{}
+
+	if before, after := guiPort("", from, u.Scheme), guiPort("", to, u.Scheme); before != after && port == before {
+		warnings = append(warnings, fmt.Sprintf(
+			"The provider connects to %s. Changing `%s` from %d to %d moves the web interface away from that port; the provider `host` must be updated accordingly after this change is applied.",
+			host, attribute, before, after,
+		))
+	}
+
+	// The web interface listens on every address unless listen_address is set.
+	listensOn := func(ip net.IP, address *string) bool {
+		return address == nil || ip.Equal(net.ParseIP(*address))
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil && listensOn(ip, current.ListenAddress) && !listensOn(ip, desired.ListenAddress) {
+		warnings = append(warnings, fmt.Sprintf(
+			"The provider connects to %s. Setting `listen_address` to %s stops the web interface from listening on that address; the provider `host` must be updated accordingly after this change is applied.",
+			host, *desired.ListenAddress,
+		))
+	}
+
+	return warnings
+}
+
+// guiPort returns the explicit port if there is one, the configured port
+// otherwise and falls back to the default port of the scheme.
+func guiPort(explicit string, configured *int, scheme string) int {
+	if p, err := strconv.Atoi(explicit); err == nil {
+		return p
+	}
+	if configured != nil {
+		return *configured
+	}
+	if scheme == "http" {
+		return *guiDefaults.HTTPPort
+	}
+	return *guiDefaults.HTTPSPort
+}
+
+type resourceServiceGUI struct {
+	p provider
+}
+
+func (r resourceServiceGUI) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.GUIID {
+		return nil, fmt.Errorf("The web interface is identified by `%s`.", types.GUIID)
+	}
+
+	var gui types.GUI
+	if err := r.p.config.Get(ctx, &gui, guiPath...); err != nil {
+		return nil, err
+	}
+	return &gui, nil
+}
+
+// Create adopts the existing settings as the web interface always exists.
+func (r resourceServiceGUI) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	current, err := r.Read(ctx, types.GUIID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Update(ctx, *(current.(*types.GUI)), plan, nil)
+}
+
+func (r resourceServiceGUI) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, guiPath...); err != nil {
+		return nil, err
+	}
+
+	// The web interface can no longer be reached with the current connection.
+	if gui := desired.(types.GUI); len(guiConnectionWarnings(r.p.host, current.(types.GUI), gui)) > 0 {
+		return &gui, nil
+	}
+	return r.Read(ctx, types.GUIID)
+}
+
+// Delete restores the defaults rather than deleting the web interface.
+func (r resourceServiceGUI) Delete(ctx context.Context, _ string) error {
+	current, err := r.Read(ctx, types.GUIID)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Update(ctx, *(current.(*types.GUI)), guiDefaults, guiPath...)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-edge/internal/types"
+)
+
+func TestGUIConnectionWarnings(t *testing.T) {
+	for _, test := range []struct {
+		name             string
+		host             string
+		current, desired types.GUI
+		expected         int
+	}{
+		{
+			name:     "default port unchanged",
+			host:     "https://192.168.1.1",
+			current:  guiDefaults,
+			desired:  types.GUI{HTTPSPort: intptr(443)},
+			expected: 0,
+		},
+		{
+			name:     "default port moved",
+			host:     "https://192.168.1.1",
+			current:  guiDefaults,
+			desired:  types.GUI{HTTPSPort: intptr(8443)},
+			expected: 1,
+		},
+		{
+			name:     "explicit port moved back to default",
+			host:     "https://192.168.1.1:8443",
+			current:  types.GUI{HTTPSPort: intptr(8443)},
+			desired:  types.GUI{},
+			expected: 1,
+		},
+		{
+			name:     "unrelated port moved",
+			host:     "https://192.168.1.1",
+			current:  guiDefaults,
+			desired:  types.GUI{HTTPPort: intptr(8080)},
+			expected: 0,
+		},
+		{
+			name:     "http port moved",
+			host:     "http://192.168.1.1",
+			current:  guiDefaults,
+			desired:  types.GUI{HTTPPort: intptr(8080)},
+			expected: 1,
+		},
+		{
+			name:     "listen address changed",
+			host:     "https://192.168.1.1",
+			current:  guiDefaults,
+			desired:  types.GUI{ListenAddress: strptr("10.0.0.1")},
+			expected: 1,
+		},
+		{
+			name:     "listen address matches",
+			host:     "https://192.168.1.1",
+			current:  guiDefaults,
+			desired:  types.GUI{ListenAddress: strptr("192.168.1.1")},
+			expected: 0,
+		},
+		{
+			name:     "unchanged listen address",
+			host:     "https://192.168.1.1",
+			current:  types.GUI{ListenAddress: strptr("10.0.0.1")},
+			desired:  types.GUI{ListenAddress: strptr("10.0.0.1")},
+			expected: 0,
+		},
+		{
+			name:     "listen address changed away from host",
+			host:     "https://192.168.1.1",
+			current:  types.GUI{ListenAddress: strptr("192.168.1.1")},
+			desired:  types.GUI{ListenAddress: strptr("10.0.0.1")},
+			expected: 1,
+		},
+		{
+			name:     "listen address removed",
+			host:     "https://192.168.1.1",
+			current:  types.GUI{ListenAddress: strptr("192.168.1.1")},
+			desired:  types.GUI{},
+			expected: 0,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if warnings := guiConnectionWarnings(test.host, test.current, test.desired); len(warnings) != test.expected {
+				t.Errorf("expected %d warnings, got %v", test.expected, warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var mdnsRepeaterPath = []string{"service", "mdns", "repeater"}
+
+type resourceServiceMDNSRepeaterType struct{}
+
+func (r resourceServiceMDNSRepeaterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaServiceMDNSRepeater(), nil
+}
+
+func (r resourceServiceMDNSRepeaterType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "mdns repeater",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceServiceMDNSRepeater{p: *(p.(*provider))},
+		Type:         types.MDNSRepeater{},
+	}, nil
+}
+
+type resourceServiceMDNSRepeater struct {
+	p provider
+}
+
+func (r resourceServiceMDNSRepeater) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.MDNSRepeaterID {
+		return nil, fmt.Errorf("The mdns repeater is identified by `%s`.", types.MDNSRepeaterID)
+	}
+
+	var repeater types.MDNSRepeater
+	if err := r.p.config.Get(ctx, &repeater, mdnsRepeaterPath...); err != nil {
+		return nil, err
+	}
+	return &repeater, nil
+}
+
+func (r resourceServiceMDNSRepeater) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	if err := r.p.config.Set(ctx, plan, mdnsRepeaterPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.MDNSRepeaterID)
+}
+
+func (r resourceServiceMDNSRepeater) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, mdnsRepeaterPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.MDNSRepeaterID)
+}
+
+func (r resourceServiceMDNSRepeater) Delete(ctx context.Context, _ string) error {
+	return r.p.config.Delete(ctx, mdnsRepeaterPath...)
+}
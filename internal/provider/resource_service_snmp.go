@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var snmpPath = []string{"service", "snmp"}
+
+type resourceServiceSNMPType struct{}
+
+func (r resourceServiceSNMPType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaServiceSNMP(), nil
+}
+
+func (r resourceServiceSNMPType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "snmp service",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceServiceSNMP{p: *(p.(*provider))},
+		Type:         types.SNMP{},
+	}, nil
+}
+
+type resourceServiceSNMP struct {
+	p provider
+}
+
+func (r resourceServiceSNMP) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.SNMPID {
+		return nil, fmt.Errorf("The snmp service is identified by `%s`.", types.SNMPID)
+	}
+
+	var snmp types.SNMP
+	if err := r.p.config.Get(ctx, &snmp, snmpPath...); err != nil {
+		return nil, err
+	}
+	return &snmp, nil
+}
+
+// Normalize fills in the plaintext keys of the v3 users which the router
+// only reports encrypted.
+func (r resourceServiceSNMP) Normalize(known, actual interface{}) interface{} {
+	k, snmp := known.(types.SNMP), actual.(*types.SNMP)
+	for name, user := range snmp.V3Users {
+		if ku, ok := k.V3Users[name]; ok && ku != nil {
+			user.AuthKey = ku.AuthKey
+			user.PrivacyKey = ku.PrivacyKey
+		}
+	}
+	return snmp
+}
+
+// Create takes over the snmp service as it is, so that communities and users
+// that are not declared are removed rather than merged into the state.
+func (r resourceServiceSNMP) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	var current types.SNMP
+	if err := r.p.config.Get(ctx, &current, snmpPath...); err != nil && !api.IsNotFound(err) {
+		return nil, err
+	}
+	return r.Update(ctx, current, plan, nil)
+}
+
+func (r resourceServiceSNMP) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, snmpPath...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, desired.(types.SNMP))
+}
+
+func (r resourceServiceSNMP) Delete(ctx context.Context, _ string) error {
+	return r.p.config.Delete(ctx, snmpPath...)
+}
+
+func (r resourceServiceSNMP) read(ctx context.Context, known types.SNMP) (interface{}, error) {
+	actual, err := r.Read(ctx, types.SNMPID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+)
+
+func TestSNMPNormalize(t *testing.T) {
+	aes := "aes"
+
+	// The router only reports the encrypted keys.
+	read := func() *types.SNMP {
+		var snmp types.SNMP
+		if err := json.Unmarshal([]byte(`{"v3":{"user":{
+			"monitor":{"mode":"ro","auth":{"type":"sha","encrypted-key":"0x1234"},"privacy":{"type":"aes","encrypted-key":"0x5678"}},
+			"imported":{"mode":"ro","auth":{"type":"md5","encrypted-key":"0x9abc"}}
+		}}}`), &snmp); err != nil {
+			t.Fatal(err)
+		}
+		return &snmp
+	}
+
+	known := types.SNMP{
+		V3Users: map[string]*types.SNMPv3User{
+			"monitor": {
+				AuthType:    "sha",
+				AuthKey:     "authsecret",
+				PrivacyType: &aes,
+				PrivacyKey:  strptr("privsecret"),
+			},
+		},
+	}
+
+	normalized := resourceServiceSNMP{}.Normalize(known, read()).(*types.SNMP)
+
+	monitor := normalized.V3Users["monitor"]
+	if monitor.AuthKey != "authsecret" || monitor.PrivacyKey == nil || *monitor.PrivacyKey != "privsecret" {
+		t.Errorf("expected the plaintext keys to be restored, got %+v", monitor)
+	}
+	if monitor.PrivacyType == nil || *monitor.PrivacyType != aes {
+		t.Errorf("expected the privacy type to be kept, got %+v", monitor)
+	}
+
+	expected := read().V3Users["imported"]
+	if imported := normalized.V3Users["imported"]; !reflect.DeepEqual(expected, imported) {
+		t.Errorf("expected a user that is not in the state to be left alone, got %+v", imported)
+	}
+
+	if empty := (resourceServiceSNMP{}).Normalize(types.SNMP{}, read()).(*types.SNMP); empty.V3Users["monitor"].AuthKey != "" {
+		t.Errorf("expected no keys without a state, got %+v", empty.V3Users["monitor"])
+	}
+}
+
+// updatingConfig records the operation of the last update.
+type updatingConfig struct {
+	fakeConfig
+	op **api.Operation
+}
+
+func (c updatingConfig) Update(_ context.Context, current, desired interface{}, path ...string) error {
+	op, err := api.NewUpdate(current, desired, path...)
+	*c.op = op
+	return err
+}
+
+func TestSNMPCreateExisting(t *testing.T) {
+	var op *api.Operation
+	config := updatingConfig{
+		fakeConfig: fakeConfig{tree: `{"service":{"snmp":{"community":{"public":{"authorization":"ro"}}}}}`},
+		op:         &op,
+	}
+	r := resourceServiceSNMP{p: provider{config: config}}
+
+	ro := "ro"
+	if _, err := r.Create(context.Background(), types.SNMP{
+		Communities: map[string]*types.SNMPCommunity{"monitoring": {Authorization: &ro}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if op == nil {
+		t.Fatal("expected the snmp service to be updated")
+	}
+	if _, ok := api.Lookup(op.Delete, append(snmpPath, "community", "public")...); !ok {
+		t.Errorf("expected the undeclared community to be deleted, got %+v", op.Delete)
+	}
+	if _, ok := api.Lookup(op.Set, append(snmpPath, "community", "monitoring", "authorization")...); !ok {
+		t.Errorf("expected the declared community to be set, got %+v", op.Set)
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var sshPath = []string{"service", "ssh"}
+
+// sshDefaults are the factory defaults of the ssh service.
+var sshDefaults = types.SSH{
+	Port:            intptr(22),
+	ProtocolVersion: strptr("v2"),
+}
+
+type resourceServiceSSHType struct{}
+
+func (r resourceServiceSSHType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaServiceSSH(), nil
+}
+
+func (r resourceServiceSSHType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "ssh service",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceServiceSSH{p: *(p.(*provider))},
+		Type:         types.SSH{},
+	}, nil
+}
+
+type resourceServiceSSH struct {
+	p provider
+}
+
+func (r resourceServiceSSH) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.SSHID {
+		return nil, fmt.Errorf("The ssh service is identified by `%s`.", types.SSHID)
+	}
+
+	var ssh types.SSH
+	if err := r.p.config.Get(ctx, &ssh, sshPath...); err != nil {
+		return nil, err
+	}
+	return &ssh, nil
+}
+
+func (r resourceServiceSSH) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.SSH)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+// Create adopts the existing settings, if any, as the ssh service is enabled
+// by default.
+func (r resourceServiceSSH) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	var current types.SSH
+	if err := r.p.config.Get(ctx, &current, sshPath...); err != nil && !api.IsNotFound(err) {
+		return nil, err
+	}
+	return r.Update(ctx, current, plan, nil)
+}
+
+func (r resourceServiceSSH) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, sshPath...); err != nil {
+		return nil, err
+	}
+
+	actual, err := r.Read(ctx, types.SSHID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(desired, actual), nil
+}
+
+// Delete restores the defaults rather than disabling the ssh service.
+func (r resourceServiceSSH) Delete(ctx context.Context, _ string) error {
+	current, err := r.Read(ctx, types.SSHID)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Update(ctx, *(current.(*types.SSH)), sshDefaults, sshPath...)
+}
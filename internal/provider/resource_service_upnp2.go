@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var upnpPath = []string{"service", "upnp2"}
+
+type resourceServiceUPnP2Type struct{}
+
+func (r resourceServiceUPnP2Type) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaServiceUPnP2(), nil
+}
+
+func (r resourceServiceUPnP2Type) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "upnp service",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceServiceUPnP2{p: *(p.(*provider))},
+		Type:         types.UPnP{},
+	}, nil
+}
+
+type resourceServiceUPnP2 struct {
+	p provider
+}
+
+func (r resourceServiceUPnP2) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.UPnPID {
+		return nil, fmt.Errorf("The upnp service is identified by `%s`.", types.UPnPID)
+	}
+
+	var upnp types.UPnP
+	if err := r.p.config.Get(ctx, &upnp, upnpPath...); err != nil {
+		return nil, err
+	}
+	return &upnp, nil
+}
+
+func (r resourceServiceUPnP2) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	if err := r.p.config.Set(ctx, plan, upnpPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.UPnPID)
+}
+
+func (r resourceServiceUPnP2) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, upnpPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.UPnPID)
+}
+
+func (r resourceServiceUPnP2) Delete(ctx context.Context, _ string) error {
+	return r.p.config.Delete(ctx, upnpPath...)
+}
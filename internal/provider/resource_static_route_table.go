@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceStaticRouteTableType struct{}
+
+func (r resourceStaticRouteTableType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaStaticRouteTable(), nil
+}
+
+func (r resourceStaticRouteTableType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "static route table",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceStaticRouteTable{p: *(p.(*provider))},
+		Type:         types.StaticRouteTable{},
+	}, nil
+}
+
+type resourceStaticRouteTable struct {
+	p provider
+}
+
+func staticRouteTablePath(id string) []string {
+	return []string{"protocols", "static", "table", id}
+}
+
+func (r resourceStaticRouteTable) Read(ctx context.Context, id string) (interface{}, error) {
+	number, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("The static route table `%s` is not a number.", id)
+	}
+
+	var table types.StaticRouteTable
+	if err := r.p.config.Get(ctx, &table, staticRouteTablePath(id)...); err != nil {
+		return nil, err
+	}
+	table.Table = number
+	return &table, nil
+}
+
+func (r resourceStaticRouteTable) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.StaticRouteTable)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+func (r resourceStaticRouteTable) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	table := plan.(types.StaticRouteTable)
+	if err := r.p.config.Set(ctx, table, staticRouteTablePath(table.GetID())...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, table)
+}
+
+func (r resourceStaticRouteTable) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	table := desired.(types.StaticRouteTable)
+	if err := r.p.config.Update(ctx, current, table, staticRouteTablePath(table.GetID())...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, table)
+}
+
+func (r resourceStaticRouteTable) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, staticRouteTablePath(id)...)
+}
+
+func (r resourceStaticRouteTable) read(ctx context.Context, known types.StaticRouteTable) (interface{}, error) {
+	actual, err := r.Read(ctx, known.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
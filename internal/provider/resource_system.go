@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var systemPath = []string{"system"}
+
+// systemDefaults are the factory defaults of the settings managed by
+// edge_system.
+var systemDefaults = types.System{
+	HostName:   strptr("ubnt"),
+	DomainName: nil,
+	TimeZone:   strptr("UTC"),
+	NTPServers: []string{
+		"0.ubnt.pool.ntp.org",
+		"1.ubnt.pool.ntp.org",
+		"2.ubnt.pool.ntp.org",
+		"3.ubnt.pool.ntp.org",
+	},
+	// The name servers are learned via DHCP by default.
+	NameServers: nil,
+	// Removing an offload flag restores its default, which depends on the
+	// model of the router. Settings such as `hwnat` are not managed and kept.
+	Offload: nil,
+}
+
+type resourceSystemType struct{}
+
+func (r resourceSystemType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaSystem(), nil
+}
+
+func (r resourceSystemType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "system settings",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceSystem{p: *(p.(*provider))},
+		Type:         types.System{},
+	}, nil
+}
+
+type resourceSystem struct {
+	p provider
+}
+
+func (r resourceSystem) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.SystemID {
+		return nil, fmt.Errorf("The system settings are identified by `%s`.", types.SystemID)
+	}
+
+	var system types.System
+	if err := r.p.config.Get(ctx, &system, systemPath...); err != nil {
+		return nil, err
+	}
+
+	// The offload node usually holds unmanaged settings such as `hwnat`.
+	if o := system.Offload; o != nil {
+		if o.IPv4 != nil && *o.IPv4 == (types.OffloadFamily{}) {
+			o.IPv4 = nil
+		}
+		if o.IPv6 != nil && *o.IPv6 == (types.OffloadFamily{}) {
+			o.IPv6 = nil
+		}
+		if *o == (types.Offload{}) {
+			system.Offload = nil
+		}
+	}
+	return &system, nil
+}
+
+// Normalize restores offload settings that are declared without any flags, as
+// Read cannot tell them apart from unmanaged ones.
+func (r resourceSystem) Normalize(known, actual interface{}) interface{} {
+	k, system := known.(types.System), actual.(*types.System)
+	if k.Offload == nil {
+		return system
+	}
+
+	if system.Offload == nil {
+		system.Offload = &types.Offload{}
+	}
+	if k.Offload.IPv4 != nil && system.Offload.IPv4 == nil {
+		system.Offload.IPv4 = &types.OffloadFamily{}
+	}
+	if k.Offload.IPv6 != nil && system.Offload.IPv6 == nil {
+		system.Offload.IPv6 = &types.OffloadFamily{}
+	}
+	return system
+}
+
+// Create adopts the existing settings as the system node always exists.
+func (r resourceSystem) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	current, err := r.Read(ctx, types.SystemID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Update(ctx, *(current.(*types.System)), plan, nil)
+}
+
+func (r resourceSystem) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	system := desired.(types.System)
+	if err := r.update(ctx, current.(types.System), system); err != nil {
+		return nil, err
+	}
+
+	actual, err := r.Read(ctx, types.SystemID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(system, actual), nil
+}
+
+// Delete restores the factory defaults rather than deleting the system node.
+func (r resourceSystem) Delete(ctx context.Context, _ string) error {
+	current, err := r.Read(ctx, types.SystemID)
+	if err != nil {
+		return err
+	}
+	return r.update(ctx, *(current.(*types.System)), systemDefaults)
+}
+
+func (r resourceSystem) update(ctx context.Context, current, desired types.System) error {
+	op, err := systemOperation(current, desired)
+	if err != nil || op.IsEmpty() {
+		return err
+	}
+	return r.p.config.Post(ctx, op)
+}
+
+// systemOperation returns the operation that transforms current into desired.
+// It only deletes the settings that are modelled by types.System, as the
+// system node holds many more.
+func systemOperation(current, desired types.System) (*api.Operation, error) {
+	return api.NewLeafUpdate(current, desired, systemPath...)
+}
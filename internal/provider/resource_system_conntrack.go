@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var conntrackPath = []string{"system", "conntrack"}
+
+// conntrackDefaults are the factory defaults of the settings managed by
+// edge_system_conntrack.
+var conntrackDefaults = types.Conntrack{
+	TableSize:       intptr(262144),
+	ExpectTableSize: intptr(2048),
+	HashSize:        intptr(32768),
+}
+
+type resourceSystemConntrackType struct{}
+
+func (r resourceSystemConntrackType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaSystemConntrack(), nil
+}
+
+func (r resourceSystemConntrackType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "conntrack settings",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceSystemConntrack{p: *(p.(*provider))},
+		Type:         types.Conntrack{},
+	}, nil
+}
+
+type resourceSystemConntrack struct {
+	p provider
+}
+
+func (r resourceSystemConntrack) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.ConntrackID {
+		return nil, fmt.Errorf("The conntrack settings are identified by `%s`.", types.ConntrackID)
+	}
+
+	var conntrack types.Conntrack
+	if err := r.p.config.Get(ctx, &conntrack, conntrackPath...); err != nil {
+		return nil, err
+	}
+	return &conntrack, nil
+}
+
+// Normalize fills in the helpers that are explicitly enabled. The router only
+// keeps a node for the disabled ones.
+func (r resourceSystemConntrack) Normalize(known, actual interface{}) interface{} {
+	k, conntrack := known.(types.Conntrack), actual.(*types.Conntrack)
+	if k.Modules == nil {
+		return conntrack
+	}
+
+	if conntrack.Modules == nil {
+		conntrack.Modules = &types.ConntrackModules{}
+	}
+	for _, module := range []struct{ known, actual **bool }{
+		{&k.Modules.FTP, &conntrack.Modules.FTP},
+		{&k.Modules.GRE, &conntrack.Modules.GRE},
+		{&k.Modules.H323, &conntrack.Modules.H323},
+		{&k.Modules.PPTP, &conntrack.Modules.PPTP},
+		{&k.Modules.SIP, &conntrack.Modules.SIP},
+		{&k.Modules.TFTP, &conntrack.Modules.TFTP},
+	} {
+		if *module.actual == nil && *module.known != nil && **module.known {
+			*module.actual = *module.known
+		}
+	}
+	return conntrack
+}
+
+// Create adopts the existing settings as the conntrack node always exists.
+func (r resourceSystemConntrack) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	current, err := r.Read(ctx, types.ConntrackID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Update(ctx, *(current.(*types.Conntrack)), plan, nil)
+}
+
+func (r resourceSystemConntrack) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, conntrackPath...); err != nil {
+		return nil, err
+	}
+
+	actual, err := r.Read(ctx, types.ConntrackID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(desired, actual), nil
+}
+
+// Delete restores the factory defaults rather than deleting the conntrack node.
+func (r resourceSystemConntrack) Delete(ctx context.Context, _ string) error {
+	current, err := r.Read(ctx, types.ConntrackID)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Update(ctx, *(current.(*types.Conntrack)), conntrackDefaults, conntrackPath...)
+}
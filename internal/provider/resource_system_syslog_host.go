@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceSystemSyslogHostType struct{}
+
+func (r resourceSystemSyslogHostType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaSystemSyslogHost(), nil
+}
+
+func (r resourceSystemSyslogHostType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "syslog host",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceSystemSyslogHost{p: *(p.(*provider))},
+		Type:         types.SyslogHost{},
+	}, nil
+}
+
+type resourceSystemSyslogHost struct {
+	p provider
+}
+
+func syslogHostPath(id string) []string {
+	return []string{"system", "syslog", "host", id}
+}
+
+func (r resourceSystemSyslogHost) Read(ctx context.Context, id string) (interface{}, error) {
+	var host types.SyslogHost
+	if err := r.p.config.Get(ctx, &host, syslogHostPath(id)...); err != nil {
+		return nil, err
+	}
+	host.Host, host.Port = types.ParseSyslogHostID(id)
+	return &host, nil
+}
+
+func (r resourceSystemSyslogHost) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	host := plan.(types.SyslogHost)
+	if err := r.p.config.Set(ctx, host, syslogHostPath(host.GetID())...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, host.GetID())
+}
+
+func (r resourceSystemSyslogHost) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	host := desired.(types.SyslogHost)
+	if err := r.p.config.Update(ctx, current, host, syslogHostPath(host.GetID())...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, host.GetID())
+}
+
+func (r resourceSystemSyslogHost) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, syslogHostPath(id)...)
+}
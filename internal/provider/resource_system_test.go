@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"terraform-provider-edge/internal/types"
+)
+
+func TestSystemOperation(t *testing.T) {
+	enable := types.Toggle(true)
+	current := types.System{
+		HostName:    strptr("router"),
+		TimeZone:    strptr("Europe/Berlin"),
+		NTPServers:  []string{"ntp.example.com"},
+		NameServers: []string{"192.0.2.1", "192.0.2.2"},
+		Offload: &types.Offload{
+			IPv4:  &types.OffloadFamily{Forwarding: &enable, VLAN: &enable},
+			IPsec: &enable,
+		},
+	}
+
+	for _, test := range []struct {
+		name     string
+		desired  types.System
+		expected string
+	}{
+		{
+			name: "offload removed",
+			desired: types.System{
+				HostName:    strptr("router"),
+				TimeZone:    strptr("Europe/Berlin"),
+				NTPServers:  []string{"ntp.example.com"},
+				NameServers: []string{"192.0.2.1", "192.0.2.2"},
+			},
+			expected: `{"SET":{"system":{"host-name":"router","name-server":["192.0.2.1","192.0.2.2"],"ntp":{"server":{"ntp.example.com":null}},"time-zone":"Europe/Berlin"}},"DELETE":{"system":{"offload":{"ipsec":null,"ipv4":{"forwarding":null,"vlan":null}}}}}`,
+		},
+		{
+			name:     "deleted",
+			desired:  systemDefaults,
+			expected: `{"SET":{"system":{"host-name":"ubnt","ntp":{"server":{"0.ubnt.pool.ntp.org":null,"1.ubnt.pool.ntp.org":null,"2.ubnt.pool.ntp.org":null,"3.ubnt.pool.ntp.org":null}},"time-zone":"UTC"}},"DELETE":{"system":{"name-server":null,"ntp":{"server":{"ntp.example.com":null}},"offload":{"ipsec":null,"ipv4":{"forwarding":null,"vlan":null}}}}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			op, err := systemOperation(current, test.desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := json.Marshal(op)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, string(data))
+			}
+		})
+	}
+}
+
+func TestSystemReadUnmanagedOffload(t *testing.T) {
+	r := resourceSystem{p: provider{
+		config: fakeConfig{tree: `{"system":{"host-name":"router","offload":{"hwnat":"enable","ipv4":{"table-size":"65536"}}}}`},
+	}}
+
+	actual, err := r.Read(context.Background(), types.SystemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if offload := actual.(*types.System).Offload; offload != nil {
+		t.Errorf("expected no offload settings, got %+v", offload)
+	}
+}
+
+func TestSystemNormalize(t *testing.T) {
+	enable := types.Toggle(true)
+
+	for _, test := range []struct {
+		name     string
+		known    *types.Offload
+		actual   *types.Offload
+		expected *types.Offload
+	}{
+		{
+			name: "undeclared",
+		},
+		{
+			name:     "declared without flags",
+			known:    &types.Offload{IPv4: &types.OffloadFamily{}},
+			expected: &types.Offload{IPv4: &types.OffloadFamily{}},
+		},
+		{
+			name:     "declared with flags",
+			known:    &types.Offload{IPsec: &enable},
+			actual:   &types.Offload{IPsec: &enable},
+			expected: &types.Offload{IPsec: &enable},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			normalized := resourceSystem{}.Normalize(
+				types.System{Offload: test.known},
+				&types.System{Offload: test.actual},
+			).(*types.System)
+
+			if !reflect.DeepEqual(normalized.Offload, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, normalized.Offload)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceSystemUserType struct{}
+
+func (r resourceSystemUserType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaSystemUser(), nil
+}
+
+func (r resourceSystemUserType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "user",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceSystemUser{p: *(p.(*provider))},
+		Type:         types.User{},
+	}, nil
+}
+
+type resourceSystemUser struct {
+	p provider
+}
+
+func userPath(name string) []string {
+	return []string{"system", "login", "user", name}
+}
+
+func (r resourceSystemUser) Read(ctx context.Context, id string) (interface{}, error) {
+	var user types.User
+	if err := r.p.config.Get(ctx, &user, userPath(id)...); err != nil {
+		return nil, err
+	}
+	user.Name = id
+	return &user, nil
+}
+
+// Normalize hides the hash the router generates for a plaintext password. The
+// hash is also hidden if no password is known at all, as it was not planned.
+func (r resourceSystemUser) Normalize(known, actual interface{}) interface{} {
+	k, user := known.(types.User), actual.(*types.User)
+	if k.Password != nil {
+		user.Password = k.Password
+		user.EncryptedPassword = nil
+	} else if k.EncryptedPassword == nil {
+		user.EncryptedPassword = nil
+	}
+	return user
+}
+
+func (r resourceSystemUser) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	user := plan.(types.User)
+	if err := r.p.config.Set(ctx, user, userPath(user.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, user)
+}
+
+func (r resourceSystemUser) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	c, d := current.(types.User), desired.(types.User)
+
+	// Setting a plaintext password replaces the hash, it must not be deleted.
+	if d.Password != nil {
+		c.EncryptedPassword = nil
+	}
+
+	if err := r.p.config.Update(ctx, c, d, userPath(d.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, d)
+}
+
+func (r resourceSystemUser) Delete(ctx context.Context, id string) error {
+	if id == r.p.username {
+		return fmt.Errorf("The provider is logged in as `%s`. Refusing to delete this user as doing so would lock the provider out of the router.", id)
+	}
+	return r.p.config.Delete(ctx, userPath(id)...)
+}
+
+func (r resourceSystemUser) read(ctx context.Context, known types.User) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceSystemUserSSHKeyType struct{}
+
+func (r resourceSystemUserSSHKeyType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaSystemUserSSHKey(), nil
+}
+
+func (r resourceSystemUserSSHKeyType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "ssh key",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceSystemUserSSHKey{p: *(p.(*provider))},
+		Type:         types.SSHKey{},
+	}, nil
+}
+
+type resourceSystemUserSSHKey struct {
+	p provider
+}
+
+func sshKeyPath(user, name string) []string {
+	return append(userPath(user), "authentication", "public-keys", name)
+}
+
+func parseSSHKeyID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("The id `%s` is not of the form `<user>/<name>`.", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (r resourceSystemUserSSHKey) Read(ctx context.Context, id string) (interface{}, error) {
+	user, name, err := parseSSHKeyID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var key types.SSHKey
+	if err := r.p.config.Get(ctx, &key, sshKeyPath(user, name)...); err != nil {
+		return nil, err
+	}
+	key.User, key.Name = user, name
+	return &key, nil
+}
+
+func (r resourceSystemUserSSHKey) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	key := plan.(types.SSHKey)
+	if err := r.p.config.Set(ctx, key, sshKeyPath(key.User, key.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, key.GetID())
+}
+
+func (r resourceSystemUserSSHKey) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	key := desired.(types.SSHKey)
+	if err := r.p.config.Update(ctx, current, key, sshKeyPath(key.User, key.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, key.GetID())
+}
+
+func (r resourceSystemUserSSHKey) Delete(ctx context.Context, id string) error {
+	user, name, err := parseSSHKeyID(id)
+	if err != nil {
+		return err
+	}
+	return r.p.config.Delete(ctx, sshKeyPath(user, name)...)
+}
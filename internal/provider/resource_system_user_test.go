@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"terraform-provider-edge/internal/types"
+)
+
+func TestSystemUserDeleteLoggedInUser(t *testing.T) {
+	r := resourceSystemUser{p: provider{username: "admin"}}
+
+	err := r.Delete(context.Background(), "admin")
+	if err == nil || !regexp.MustCompile("Refusing to delete this user").MatchString(err.Error()) {
+		t.Errorf("expected the deletion of the logged in user to be refused, got %v", err)
+	}
+}
+
+func TestSystemUserNormalize(t *testing.T) {
+	user := func(password, hash *string) types.User {
+		return types.User{Name: "alice", Password: password, EncryptedPassword: hash}
+	}
+
+	for _, test := range []struct {
+		name          string
+		known, actual types.User
+		expected      types.User
+	}{
+		{
+			name:     "known plaintext",
+			known:    user(strptr("secret"), nil),
+			actual:   user(nil, strptr("$6$old")),
+			expected: user(strptr("secret"), nil),
+		},
+		{
+			name:     "imported without plaintext",
+			known:    user(nil, strptr("$6$old")),
+			actual:   user(nil, strptr("$6$old")),
+			expected: user(nil, strptr("$6$old")),
+		},
+		{
+			name:     "no password configured",
+			known:    types.User{Name: "alice"},
+			actual:   user(nil, strptr("$6$old")),
+			expected: types.User{Name: "alice"},
+		},
+		{
+			name:     "password change",
+			known:    user(strptr("changed"), nil),
+			actual:   user(nil, strptr("$6$new")),
+			expected: user(strptr("changed"), nil),
+		},
+	} {
+		actual := test.actual
+		normalized := resourceSystemUser{}.Normalize(test.known, &actual).(*types.User)
+		if !reflect.DeepEqual(test.expected, *normalized) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, *normalized)
+		}
+	}
+}
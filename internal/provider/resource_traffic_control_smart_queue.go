@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceTrafficControlSmartQueueType struct{}
+
+func (r resourceTrafficControlSmartQueueType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaTrafficControlSmartQueue(), nil
+}
+
+func (r resourceTrafficControlSmartQueueType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "smart queue",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceTrafficControlSmartQueue{p: *(p.(*provider))},
+		Type:         types.SmartQueue{},
+	}, nil
+}
+
+type resourceTrafficControlSmartQueue struct {
+	p provider
+}
+
+func smartQueuePath(name string) []string {
+	return []string{"traffic-control", "smart-queue", name}
+}
+
+func (r resourceTrafficControlSmartQueue) Read(ctx context.Context, id string) (interface{}, error) {
+	var queue types.SmartQueue
+	if err := r.p.config.Get(ctx, &queue, smartQueuePath(id)...); err != nil {
+		return nil, err
+	}
+	queue.Name = id
+	return &queue, nil
+}
+
+func (r resourceTrafficControlSmartQueue) Normalize(known, actual interface{}) interface{} {
+	k := known.(types.SmartQueue)
+	utils.Normalize(&k, actual)
+	return actual
+}
+
+func (r resourceTrafficControlSmartQueue) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	queue := plan.(types.SmartQueue)
+	if err := r.p.config.Set(ctx, queue, smartQueuePath(queue.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, queue)
+}
+
+func (r resourceTrafficControlSmartQueue) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	queue := desired.(types.SmartQueue)
+	if err := r.p.config.Update(ctx, current, queue, smartQueuePath(queue.Name)...); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, queue)
+}
+
+func (r resourceTrafficControlSmartQueue) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, smartQueuePath(id)...)
+}
+
+func (r resourceTrafficControlSmartQueue) read(ctx context.Context, known types.SmartQueue) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceTrafficPolicyShaperType struct{}
+
+func (r resourceTrafficPolicyShaperType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaTrafficPolicyShaper(), nil
+}
+
+func (r resourceTrafficPolicyShaperType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "traffic shaper",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceTrafficPolicyShaper{p: *(p.(*provider))},
+		Type:         types.Shaper{},
+	}, nil
+}
+
+type resourceTrafficPolicyShaper struct {
+	p provider
+}
+
+func shaperPath(name string) []string {
+	return []string{"traffic-policy", "shaper", name}
+}
+
+func (r resourceTrafficPolicyShaper) Read(ctx context.Context, id string) (interface{}, error) {
+	var shaper types.Shaper
+	if err := r.p.config.Get(ctx, &shaper, shaperPath(id)...); err != nil {
+		return nil, err
+	}
+	shaper.Name = id
+	return &shaper, nil
+}
+
+func (r resourceTrafficPolicyShaper) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	shaper := plan.(types.Shaper)
+	if err := r.p.config.Set(ctx, shaper, shaperPath(shaper.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, shaper.Name)
+}
+
+func (r resourceTrafficPolicyShaper) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	shaper := desired.(types.Shaper)
+	if err := r.p.config.Update(ctx, current, shaper, shaperPath(shaper.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, shaper.Name)
+}
+
+func (r resourceTrafficPolicyShaper) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, shaperPath(id)...)
+}
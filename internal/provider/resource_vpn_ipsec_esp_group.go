@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceVPNIPsecESPGroupType struct{}
+
+func (r resourceVPNIPsecESPGroupType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaVPNIPsecESPGroup(), nil
+}
+
+func (r resourceVPNIPsecESPGroupType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "ipsec esp group",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceVPNIPsecESPGroup{p: *(p.(*provider))},
+		Type:         types.ESPGroup{},
+	}, nil
+}
+
+type resourceVPNIPsecESPGroup struct {
+	p provider
+}
+
+func espGroupPath(name string) []string {
+	return []string{"vpn", "ipsec", "esp-group", name}
+}
+
+func (r resourceVPNIPsecESPGroup) Read(ctx context.Context, id string) (interface{}, error) {
+	var group types.ESPGroup
+	if err := r.p.config.Get(ctx, &group, espGroupPath(id)...); err != nil {
+		return nil, err
+	}
+	group.Name = id
+	return &group, nil
+}
+
+func (r resourceVPNIPsecESPGroup) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	group := plan.(types.ESPGroup)
+	if err := r.p.config.Set(ctx, group, espGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, group.Name)
+}
+
+func (r resourceVPNIPsecESPGroup) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	group := desired.(types.ESPGroup)
+	if err := r.p.config.Update(ctx, current, group, espGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, group.Name)
+}
+
+func (r resourceVPNIPsecESPGroup) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, espGroupPath(id)...)
+}
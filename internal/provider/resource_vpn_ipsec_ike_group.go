@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceVPNIPsecIKEGroupType struct{}
+
+func (r resourceVPNIPsecIKEGroupType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaVPNIPsecIKEGroup(), nil
+}
+
+func (r resourceVPNIPsecIKEGroupType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "ipsec ike group",
+		Attribute:    "name",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceVPNIPsecIKEGroup{p: *(p.(*provider))},
+		Type:         types.IKEGroup{},
+	}, nil
+}
+
+type resourceVPNIPsecIKEGroup struct {
+	p provider
+}
+
+func ikeGroupPath(name string) []string {
+	return []string{"vpn", "ipsec", "ike-group", name}
+}
+
+func (r resourceVPNIPsecIKEGroup) Read(ctx context.Context, id string) (interface{}, error) {
+	var group types.IKEGroup
+	if err := r.p.config.Get(ctx, &group, ikeGroupPath(id)...); err != nil {
+		return nil, err
+	}
+	group.Name = id
+	return &group, nil
+}
+
+func (r resourceVPNIPsecIKEGroup) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	group := plan.(types.IKEGroup)
+	if err := r.p.config.Set(ctx, group, ikeGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, group.Name)
+}
+
+func (r resourceVPNIPsecIKEGroup) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	group := desired.(types.IKEGroup)
+	if err := r.p.config.Update(ctx, current, group, ikeGroupPath(group.Name)...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, group.Name)
+}
+
+func (r resourceVPNIPsecIKEGroup) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, ikeGroupPath(id)...)
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/api"
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+type resourceVPNIPsecSiteToSitePeerType struct{}
+
+func (r resourceVPNIPsecSiteToSitePeerType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaVPNIPsecSiteToSitePeer(), nil
+}
+
+func (r resourceVPNIPsecSiteToSitePeerType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "ipsec site-to-site peer",
+		Attribute:    "peer",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceVPNIPsecSiteToSitePeer{p: *(p.(*provider))},
+		Type:         types.SiteToSitePeer{},
+	}, nil
+}
+
+type resourceVPNIPsecSiteToSitePeer struct {
+	p provider
+}
+
+func siteToSitePeerPath(peer string) []string {
+	return []string{"vpn", "ipsec", "site-to-site", "peer", peer}
+}
+
+func (r resourceVPNIPsecSiteToSitePeer) Read(ctx context.Context, id string) (interface{}, error) {
+	var peer types.SiteToSitePeer
+	if err := r.p.config.Get(ctx, &peer, siteToSitePeerPath(id)...); err != nil {
+		return nil, err
+	}
+	peer.Peer = id
+	return &peer, nil
+}
+
+// Normalize carries over the local firewall settings as they are not part of
+// the peer's configuration node.
+func (r resourceVPNIPsecSiteToSitePeer) Normalize(known, actual interface{}) interface{} {
+	peer := actual.(*types.SiteToSitePeer)
+	peer.LocalFirewall = known.(types.SiteToSitePeer).LocalFirewall
+	return peer
+}
+
+func (r resourceVPNIPsecSiteToSitePeer) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	peer := plan.(types.SiteToSitePeer)
+
+	if err := r.checkFirewallRules(ctx, nil, &peer); err != nil {
+		return nil, err
+	}
+
+	op, err := api.NewSet(peer, siteToSitePeerPath(peer.Peer)...)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := peerFirewallOperation(nil, &peer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.p.config.Post(ctx, op.Merge(rules)); err != nil {
+		return nil, err
+	}
+	return r.read(ctx, peer)
+}
+
+func (r resourceVPNIPsecSiteToSitePeer) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	c, d := current.(types.SiteToSitePeer), desired.(types.SiteToSitePeer)
+
+	if err := r.checkFirewallRules(ctx, &c, &d); err != nil {
+		return nil, err
+	}
+
+	op, err := api.NewUpdate(c, d, siteToSitePeerPath(d.Peer)...)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := peerFirewallOperation(&c, &d)
+	if err != nil {
+		return nil, err
+	}
+
+	if op = op.Merge(rules); !op.IsEmpty() {
+		if err := r.p.config.Post(ctx, op); err != nil {
+			return nil, err
+		}
+	}
+	return r.read(ctx, d)
+}
+
+func (r resourceVPNIPsecSiteToSitePeer) Delete(ctx context.Context, id string) error {
+	return r.p.config.Delete(ctx, siteToSitePeerPath(id)...)
+}
+
+// Finalize deletes the peer along with the firewall rules that were generated
+// for it.
+func (r resourceVPNIPsecSiteToSitePeer) Finalize(ctx context.Context, known interface{}) error {
+	peer := known.(types.SiteToSitePeer)
+
+	rules, err := peerFirewallOperation(&peer, nil)
+	if err != nil {
+		return err
+	}
+
+	return r.p.config.Post(ctx, api.NewDelete(siteToSitePeerPath(peer.Peer)...).Merge(rules))
+}
+
+func (r resourceVPNIPsecSiteToSitePeer) read(ctx context.Context, known types.SiteToSitePeer) (interface{}, error) {
+	actual, err := r.Read(ctx, known.Peer)
+	if err != nil {
+		return nil, err
+	}
+	return r.Normalize(known, actual), nil
+}
+
+// checkFirewallRules refuses to overwrite rules that were not generated for
+// current, e.g. rules declared by a managed ruleset that ignores undeclared
+// rules.
+func (r resourceVPNIPsecSiteToSitePeer) checkFirewallRules(ctx context.Context, current, desired *types.SiteToSitePeer) error {
+	c, d := peerFirewallRules(current), peerFirewallRules(desired)
+	sameRuleset := c != nil && d != nil && current.LocalFirewall.Ruleset == desired.LocalFirewall.Ruleset
+
+	for priority := range d {
+		if _, ok := c[priority]; ok && sameRuleset {
+			continue
+		}
+
+		var existing interface{}
+		err := r.p.config.Get(ctx, &existing, "firewall", "name", desired.LocalFirewall.Ruleset, "rule", priority)
+		if err == nil {
+			return fmt.Errorf("The ruleset `%s` already has a rule with priority %s.", desired.LocalFirewall.Ruleset, priority)
+		} else if !api.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// peerFirewallRules returns the rules, keyed by priority, that accept IKE,
+// NAT-T and ESP traffic from the peer.
+func peerFirewallRules(peer *types.SiteToSitePeer) map[string]interface{} {
+	if peer == nil || peer.LocalFirewall == nil {
+		return nil
+	}
+
+	source := map[string]interface{}{}
+	if net.ParseIP(peer.Peer) != nil && peer.Peer != "0.0.0.0" {
+		source["address"] = peer.Peer
+	}
+
+	rule := func(description, protocol, port string) map[string]interface{} {
+		r := map[string]interface{}{
+			"action":      "accept",
+			"description": fmt.Sprintf("%s from IPsec peer %s", description, peer.Peer),
+			"protocol":    protocol,
+		}
+		if len(source) > 0 {
+			r["source"] = source
+		}
+		if port != "" {
+			r["destination"] = map[string]interface{}{
+				"port": port,
+			}
+		}
+		return r
+	}
+
+	priority := peer.LocalFirewall.Priority
+	return map[string]interface{}{
+		strconv.Itoa(priority):     rule("IKE", "udp", "500"),
+		strconv.Itoa(priority + 1): rule("NAT-T", "udp", "4500"),
+		strconv.Itoa(priority + 2): rule("ESP", "esp", ""),
+	}
+}
+
+// peerFirewallOperation returns the operation that transforms the firewall
+// rules generated for current into those generated for desired. Either may be
+// nil.
+func peerFirewallOperation(current, desired *types.SiteToSitePeer) (*api.Operation, error) {
+	op := new(api.Operation)
+
+	c, d := peerFirewallRules(current), peerFirewallRules(desired)
+	sameRuleset := c != nil && d != nil && current.LocalFirewall.Ruleset == desired.LocalFirewall.Ruleset
+
+	for priority := range c {
+		if _, ok := d[priority]; !ok || !sameRuleset {
+			op.Merge(api.NewDelete("firewall", "name", current.LocalFirewall.Ruleset, "rule", priority))
+		}
+	}
+
+	for priority, rule := range d {
+		var existing interface{}
+		if sameRuleset {
+			existing = c[priority]
+		}
+
+		update, err := api.NewUpdate(existing, rule, "firewall", "name", desired.LocalFirewall.Ruleset, "rule", priority)
+		if err != nil {
+			return nil, err
+		}
+		op.Merge(update)
+	}
+
+	return op, nil
+}
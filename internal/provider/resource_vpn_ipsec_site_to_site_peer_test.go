@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"terraform-provider-edge/internal/types"
+)
+
+func TestPeerFirewallOperation(t *testing.T) {
+	peer := func(ruleset string, priority int) *types.SiteToSitePeer {
+		return &types.SiteToSitePeer{
+			Peer: "192.0.2.1",
+			LocalFirewall: &types.PeerFirewall{
+				Ruleset:  ruleset,
+				Priority: priority,
+			},
+		}
+	}
+
+	for _, test := range []struct {
+		name             string
+		current, desired *types.SiteToSitePeer
+		expected         string
+	}{
+		{
+			name:     "unchanged",
+			current:  peer("WAN_LOCAL", 10),
+			desired:  peer("WAN_LOCAL", 10),
+			expected: `{}`,
+		},
+		{
+			name:     "removed",
+			current:  peer("WAN_LOCAL", 10),
+			expected: `{"DELETE":{"firewall":{"name":{"WAN_LOCAL":{"rule":{"10":null,"11":null,"12":null}}}}}}`,
+		},
+		{
+			name:     "moved",
+			current:  peer("WAN_LOCAL", 10),
+			desired:  peer("WAN_LOCAL", 11),
+			expected: `{"SET":{"firewall":{"name":{"WAN_LOCAL":{"rule":{"11":{"action":"accept","description":"IKE from IPsec peer 192.0.2.1","destination":{"port":"500"},"protocol":"udp","source":{"address":"192.0.2.1"}},"12":{"action":"accept","description":"NAT-T from IPsec peer 192.0.2.1","destination":{"port":"4500"},"protocol":"udp","source":{"address":"192.0.2.1"}},"13":{"action":"accept","description":"ESP from IPsec peer 192.0.2.1","protocol":"esp","source":{"address":"192.0.2.1"}}}}}}},"DELETE":{"firewall":{"name":{"WAN_LOCAL":{"rule":{"10":null}}}}}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			op, err := peerFirewallOperation(test.current, test.desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := json.Marshal(op)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, string(data))
+			}
+		})
+	}
+}
+
+func TestPeerFirewallRules(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		peer     string
+		expected map[string]interface{}
+	}{
+		{
+			name: "address",
+			peer: "192.0.2.1",
+			expected: map[string]interface{}{
+				"10": map[string]interface{}{"action": "accept", "description": "IKE from IPsec peer 192.0.2.1", "protocol": "udp", "source": map[string]interface{}{"address": "192.0.2.1"}, "destination": map[string]interface{}{"port": "500"}},
+				"11": map[string]interface{}{"action": "accept", "description": "NAT-T from IPsec peer 192.0.2.1", "protocol": "udp", "source": map[string]interface{}{"address": "192.0.2.1"}, "destination": map[string]interface{}{"port": "4500"}},
+				"12": map[string]interface{}{"action": "accept", "description": "ESP from IPsec peer 192.0.2.1", "protocol": "esp", "source": map[string]interface{}{"address": "192.0.2.1"}},
+			},
+		},
+		{
+			name: "any",
+			peer: "0.0.0.0",
+			expected: map[string]interface{}{
+				"10": map[string]interface{}{"action": "accept", "description": "IKE from IPsec peer 0.0.0.0", "protocol": "udp", "destination": map[string]interface{}{"port": "500"}},
+				"11": map[string]interface{}{"action": "accept", "description": "NAT-T from IPsec peer 0.0.0.0", "protocol": "udp", "destination": map[string]interface{}{"port": "4500"}},
+				"12": map[string]interface{}{"action": "accept", "description": "ESP from IPsec peer 0.0.0.0", "protocol": "esp"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rules := peerFirewallRules(&types.SiteToSitePeer{
+				Peer: test.peer,
+				LocalFirewall: &types.PeerFirewall{
+					Ruleset:  "WAN_LOCAL",
+					Priority: 10,
+				},
+			})
+
+			if !reflect.DeepEqual(rules, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, rules)
+			}
+		})
+	}
+}
+
+func TestPeerCheckFirewallRules(t *testing.T) {
+	peer := func(priority int) *types.SiteToSitePeer {
+		return &types.SiteToSitePeer{
+			Peer: "192.0.2.1",
+			LocalFirewall: &types.PeerFirewall{
+				Ruleset:  "WAN_LOCAL",
+				Priority: priority,
+			},
+		}
+	}
+
+	r := resourceVPNIPsecSiteToSitePeer{p: provider{
+		config: fakeConfig{tree: `{"firewall":{"name":{"WAN_LOCAL":{"rule":{"10":{"action":"accept"},"11":{"action":"accept"},"12":{"action":"accept"},"20":{"action":"drop"}}}}}}`},
+	}}
+
+	for _, test := range []struct {
+		name             string
+		current, desired *types.SiteToSitePeer
+		expected         string
+	}{
+		{name: "free", desired: peer(30)},
+		{name: "taken", desired: peer(18), expected: "already has a rule with priority 20"},
+		{name: "owned", current: peer(10), desired: peer(10)},
+		{name: "moved onto a taken rule", current: peer(10), desired: peer(18), expected: "already has a rule with priority 20"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := r.checkFirewallRules(context.Background(), test.current, test.desired)
+
+			if test.expected == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if test.expected != "" && (err == nil || !strings.Contains(err.Error(), test.expected)) {
+				t.Errorf("expected an error containing %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
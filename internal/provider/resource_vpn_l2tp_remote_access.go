@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"terraform-provider-edge/internal/types"
+	"terraform-provider-edge/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var l2tpRemoteAccessPath = []string{"vpn", "l2tp", "remote-access"}
+
+type resourceVPNL2TPRemoteAccessType struct{}
+
+func (r resourceVPNL2TPRemoteAccessType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return schemaVPNL2TPRemoteAccess(), nil
+}
+
+func (r resourceVPNL2TPRemoteAccessType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return utils.Resource{
+		Name:         "l2tp remote access server",
+		Attribute:    "id",
+		IsConfigured: (p.(*provider)).configured,
+		Api:          resourceVPNL2TPRemoteAccess{p: *(p.(*provider))},
+		Type:         types.L2TPRemoteAccess{},
+	}, nil
+}
+
+type resourceVPNL2TPRemoteAccess struct {
+	p provider
+}
+
+func (r resourceVPNL2TPRemoteAccess) Read(ctx context.Context, id string) (interface{}, error) {
+	if id != types.L2TPRemoteAccessID {
+		return nil, fmt.Errorf("The l2tp remote access server is identified by `%s`.", types.L2TPRemoteAccessID)
+	}
+
+	var server types.L2TPRemoteAccess
+	if err := r.p.config.Get(ctx, &server, l2tpRemoteAccessPath...); err != nil {
+		return nil, err
+	}
+	return &server, nil
+}
+
+func (r resourceVPNL2TPRemoteAccess) Create(ctx context.Context, plan interface{}) (interface{}, error) {
+	if err := r.p.config.Set(ctx, plan, l2tpRemoteAccessPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.L2TPRemoteAccessID)
+}
+
+func (r resourceVPNL2TPRemoteAccess) Update(ctx context.Context, current, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	if err := r.p.config.Update(ctx, current, desired, l2tpRemoteAccessPath...); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, types.L2TPRemoteAccessID)
+}
+
+func (r resourceVPNL2TPRemoteAccess) Delete(ctx context.Context, _ string) error {
+	return r.p.config.Delete(ctx, l2tpRemoteAccessPath...)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func bgpFilterAttribute(kind string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "The " + kind + "s that filter the routes exchanged with this neighbor.",
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"import": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the " + kind + " applied to the routes received from this neighbor.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"export": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the " + kind + " applied to the routes announced to this neighbor.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+		}),
+		Optional: true,
+	}
+}
+
+func schemaBGPNeighbor() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A neighbor of the BGP process of the router.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource, of the form `<asn>/<address>`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"asn": {
+				Type:          types.NumberType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The local AS number. It must match the `asn` of the `edge_protocols_bgp` resource.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(4294967295)),
+				},
+			},
+			"address": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The address of the neighbor.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"remote_as": {
+				Type:        types.NumberType,
+				Required:    true,
+				Description: "The AS number of the neighbor.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(4294967295)),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this neighbor.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"password": {
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password used to sign the TCP segments of the session with MD5.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"update_source": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The address or interface the session is established from.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"ebgp_multihop": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The maximum number of hops to an external neighbor that is not directly connected.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(255)),
+				},
+			},
+			"next_hop_self": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Announce this router as the next hop of the routes announced to this neighbor.",
+			},
+			"shutdown": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Administratively shut the session down without removing the neighbor.",
+			},
+			"route_map":   bgpFilterAttribute("route map"),
+			"prefix_list": bgpFilterAttribute("prefix list"),
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaConfigNode() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "An arbitrary node of the configuration, for settings that no other resource manages. Only the node at `path` is managed. A node that already exists must be imported rather than created. It can be imported with the path separated by spaces as the id, e.g. `service lldp interface all`, or, if an element of the path contains whitespace, with the path as a JSON array, e.g. `[\"service\", \"lldp\", \"interface\", \"all\"]`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the path separated by spaces, or the path as a JSON array if an element contains whitespace.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"path": {
+				Type:          types.ListType{ElemType: types.StringType},
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The path of the node, e.g. `[\"service\", \"lldp\", \"interface\", \"all\"]`.",
+				Validators: []tfsdk.AttributeValidator{
+					minItems(1),
+				},
+			},
+			"value": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The value of a leaf node. Conflicts with `json`. If neither is set, the node is valueless.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.ConflictsWith("json"),
+				},
+			},
+			"json": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The subtree of the node as JSON, e.g. `jsonencode({ \"transmit-interval\" = \"30\" })`. Like the router, all values must be strings and valueless nodes `null`. Conflicts with `value`.",
+				Validators: []tfsdk.AttributeValidator{
+					validJSON(),
+					validators.ConflictsWith("value"),
+				},
+			},
+		},
+	}
+}
@@ -33,6 +33,11 @@ func schemaFirewallAddressGroup() tfsdk.Schema {
 					validators.MinLength(1),
 				},
 			},
+			"ignore_undeclared_members": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Ignore the cidrs of this group that are not declared here, e.g. because they are managed by the `firewall_address_group_member` resource. Otherwise, such members are removed.",
+			},
 			"cidrs": {
 				Type:        types.ListType{ElemType: types.StringType},
 				Optional:    true,
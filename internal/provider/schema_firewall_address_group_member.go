@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaFirewallAddressGroupMember() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A single cidr of an address group. The group must set `ignore_undeclared_members` so that it does not remove this cidr. It can be imported with the id `<group>/<cidr>`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be `<group>/<cidr>`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"group": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The name of the address group this cidr belongs to. The group must already exist.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"cidr": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The cidr to add to the group.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Cidr(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaFirewallGlobalOptions() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The global firewall options of the router. There is only one set per router; it can be imported with the id `firewall`. Attributes that are not set are removed from the configuration and deleting this resource restores the factory defaults.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `firewall`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"all_ping": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Respond to ICMP echo requests.",
+			},
+			"broadcast_ping": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Respond to ICMP echo requests sent to a broadcast address.",
+			},
+			"ip_src_route": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Accept IPv4 packets with the source route option.",
+			},
+			"log_martians": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Log packets with impossible source addresses.",
+			},
+			"receive_redirects": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Accept ICMPv4 redirects.",
+			},
+			"send_redirects": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Send ICMPv4 redirects.",
+			},
+			"source_validation": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Reverse path filtering of the source address of IPv4 packets. Must be one of `strict`, `loose`, `disable`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true, "strict", "loose", "disable"),
+				},
+			},
+			"syn_cookies": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Use TCP SYN cookies to protect against SYN floods.",
+			},
+			"twa_hazards_protection": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Protect against TIME-WAIT assassination hazards as described in RFC 1337.",
+			},
+			"ipv6_receive_redirects": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Accept ICMPv6 redirects.",
+			},
+			"ipv6_src_route": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Accept IPv6 packets with a routing header.",
+			},
+			"mss_clamp": {
+				Description: "Clamp the maximum segment size of TCP connections, e.g. to avoid fragmentation over PPPoE.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"interface_types": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Required:    true,
+						Description: "The kinds of interfaces to clamp the traffic of. Each must be one of `all`, `pppoe`, `pptp`, `tun`, `vti`.",
+					},
+					"mss": {
+						Type:        types.NumberType,
+						Required:    true,
+						Description: "The maximum segment size in bytes.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(500), float64(1460)),
+						},
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaFirewallModifyRuleset() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A grouping of rules that modify packets rather than filter them, e.g. to route traffic through a different routing table. The ruleset is not enforced unless attached to an interface which can be done with the `modify` attribute of the `firewall_ruleset_attachment` resource.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Description: "A unique, human readable name for this ruleset.",
+				Type:        types.StringType,
+				Required:    true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+			},
+			"description": {
+				Description: "A human readable description for this ruleset.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"rule": {
+				Description: ruleBlockDescription,
+				Validators: []tfsdk.AttributeValidator{
+					validators.Unique("priority"),
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+				Attributes: withAttributes(firewallRuleAttributes(), map[string]tfsdk.Attribute{
+					"priority": {
+						Type:        types.NumberType,
+						Required:    true,
+						Description: "The priority of this rule. The higher the priority, the higher the precedence.",
+					},
+					"action": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The action to take on traffic that matches this rule. Must be one of `modify`, `accept`, `drop`. `accept` stops the evaluation of the ruleset without modifying the packet.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "modify", "accept", "drop"),
+						},
+					},
+					"modify": {
+						Description: "How to modify the traffic that matches this rule. Only used when `action` is `modify`.",
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"table": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The routing table to route the traffic with. Either the number of a table managed by `edge_static_route_table` or `main`.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+							"mark": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "Set the packet mark.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(0), float64(2147483647)),
+								},
+							},
+							"dscp": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "Set the DSCP field of the packet.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(0), float64(63)),
+								},
+							},
+							"connmark": {
+								Description: "Set, save or restore the connection mark.",
+								Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+									"set_mark": {
+										Type:        types.NumberType,
+										Optional:    true,
+										Description: "Set the connection mark.",
+										Validators: []tfsdk.AttributeValidator{
+											validators.Range(float64(0), float64(2147483647)),
+										},
+									},
+									"save_mark": {
+										Type:        types.BoolType,
+										Optional:    true,
+										Description: "Copy the packet mark to the connection mark.",
+									},
+									"restore_mark": {
+										Type:        types.BoolType,
+										Optional:    true,
+										Description: "Copy the connection mark to the packet mark.",
+									},
+								}),
+								Optional: true,
+							},
+							"lb_group": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The load balancing group to route the traffic with.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+						}),
+						Optional: true,
+					},
+				}),
+			},
+		},
+	}
+}
@@ -33,6 +33,11 @@ func schemaFirewallPortGroup() tfsdk.Schema {
 					validators.MinLength(1),
 				},
 			},
+			"ignore_undeclared_members": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Ignore the ports and port ranges of this group that are not declared here, e.g. because they are managed by the `firewall_port_group_member` resource. Otherwise, such members are removed.",
+			},
 			"port_ranges": {
 				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
 					"from": {
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaFirewallPortGroupMember() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A single port or port range of a port group. The group must set `ignore_undeclared_members` so that it does not remove this port. It can be imported with the id `<group>/<port>` or `<group>/<from>-<to>`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be `<group>/<port>` or `<group>/<from>-<to>`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"group": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The name of the port group this port belongs to. The group must already exist.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"from": {
+				Type:          types.NumberType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The port to add to the group, or the first port of the range if `to` is set.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(65535.0)),
+					validators.Compare(validators.ComparatorLessThan, "to"),
+				},
+			},
+			"to": {
+				Type:          types.NumberType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The last port of the range to add to the group.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(65535.0)),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaFirewallRule() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A single rule of a ruleset. The ruleset must set `ignore_undeclared_rules` so that it does not remove this rule. It can be imported with the id `<ruleset>/<priority>`.",
+		Attributes: withAttributes(firewallRulesetRuleAttributes(), map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be `<ruleset>/<priority>`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"ruleset": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The name of the ruleset this rule belongs to.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"priority": {
+				Type:          types.NumberType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The priority of this rule. It must be unique within the ruleset.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(9999)),
+				},
+			},
+		}),
+	}
+}
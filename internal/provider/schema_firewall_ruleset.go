@@ -61,7 +61,10 @@ var (
 	}
 )
 
-func schemaFirewallRuleset() tfsdk.Schema {
+// firewallMatchAttributes returns the attributes that describe the protocol,
+// source and destination of the traffic a rule matches. They are shared by
+// every resource that classifies traffic.
+func firewallMatchAttributes() map[string]tfsdk.Attribute {
 	port := tfsdk.Attribute{
 		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
 			"from": {
@@ -81,19 +84,13 @@ func schemaFirewallRuleset() tfsdk.Schema {
 			},
 		}),
 		Optional:    true,
-		Description: "A port range. Conflicts with `port_group`.",
-		Validators: []tfsdk.AttributeValidator{
-			validators.ConflictsWith("port_group"),
-		},
+		Description: "A port range. Conflicts with `port_group`. Requires `protocol` to be one of `tcp`, `udp`, `tcp_udp`.",
 	}
 
 	portGroup := tfsdk.Attribute{
 		Type:        types.StringType,
 		Optional:    true,
-		Description: "The port group this rule applies to. If not provided, all ports will be matched. Conflicts with `port`.",
-		Validators: []tfsdk.AttributeValidator{
-			validators.ConflictsWith("port"),
-		},
+		Description: "The port group this rule applies to. If not provided, all ports will be matched. Conflicts with `port`. Requires `protocol` to be one of `tcp`, `udp`, `tcp_udp`.",
 	}
 
 	address := tfsdk.Attribute{
@@ -102,7 +99,6 @@ func schemaFirewallRuleset() tfsdk.Schema {
 		Description: "The cidr this rule applies to. If not provided, it is treated as `0.0.0.0/0`. Conflicts with `address_group`.",
 		Validators: []tfsdk.AttributeValidator{
 			validators.Cidr(),
-			validators.ConflictsWith("address_group"),
 		},
 	}
 
@@ -110,12 +106,287 @@ func schemaFirewallRuleset() tfsdk.Schema {
 		Type:        types.StringType,
 		Optional:    true,
 		Description: "The address group this rule applies to. If not provided, all addresses will be matched. Conflicts with `address`.",
-		Validators: []tfsdk.AttributeValidator{
-			validators.ConflictsWith("address"),
+	}
+
+	return map[string]tfsdk.Attribute{
+		"protocol": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The protocol this rule applies to. If not specified, this rule applies to all protcols. Values prefixed with `!` specifies a _not_ behavior. If `!` is provided, this rule applies to all protocols except this one.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.StringInSlice(true, append(
+					append(
+						protocols,
+						utils.WithPrefix("!", protocols)...,
+					),
+					"all", "*",
+				)...),
+			},
+		},
+		"destination": {
+			Description: "Details about the traffic's destination. If not specified, all sources will be evaluated.",
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"address":       address,
+				"port":          port,
+				"address_group": addressGroup,
+				"port_group":    portGroup,
+			}),
+			Optional: true,
+			Validators: []tfsdk.AttributeValidator{
+				endpoint(),
+			},
+		},
+		"source": {
+			Description: "Details about the traffic's source. If not specified, all sources will be evaluated.",
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"address":       address,
+				"port":          port,
+				"address_group": addressGroup,
+				"port_group":    portGroup,
+				"mac": {
+					Type:     types.StringType,
+					Optional: true,
+				},
+			}),
+			Optional: true,
+			Validators: []tfsdk.AttributeValidator{
+				endpoint(),
+			},
 		},
 	}
+}
+
+// ruleBlockDescription explains how terraform plans changes to the `rule`
+// blocks of a ruleset.
+const ruleBlockDescription = "The rules of the ruleset. They may be declared in any order; the router evaluates them by ascending `priority`. Terraform compares the blocks by position, so inserting a rule shows every later rule as changed in the plan. Only the rules whose attributes actually change are written to the router."
+
+// firewallRuleAttributes returns the attributes that describe the traffic a
+// rule matches. They are shared by every kind of ruleset.
+func firewallRuleAttributes() map[string]tfsdk.Attribute {
+	return withAttributes(firewallMatchAttributes(), map[string]tfsdk.Attribute{
+		"description": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "A human readable description for this rule.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.MinLength(1),
+			},
+		},
+		"log": {
+			Type:        types.BoolType,
+			Optional:    true,
+			Description: "Turn on logging for this rule. These rotated logs can be found in /var/log/messages on your router.",
+		},
+		"state": {
+			Description: "This describes the connection state of a packet.",
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"established": {
+					Type:        types.BoolType,
+					Optional:    true,
+					Description: "Match packets that are part of a two-way connection.",
+				},
+				"new": {
+					Type:        types.BoolType,
+					Optional:    true,
+					Description: "Match packets creating a new connection.",
+				},
+				"related": {
+					Type:        types.BoolType,
+					Optional:    true,
+					Description: "Match packets related to established connections.",
+				},
+				"invalid": {
+					Type:        types.BoolType,
+					Optional:    true,
+					Description: "Match packets that cannot be identified.",
+				},
+			}),
+			Optional: true,
+		},
+	})
+}
 
+// firewallRulesetRuleAttributes returns the attributes of a rule of a
+// ruleset. They are shared by the ruleset and the standalone rule.
+func firewallRulesetRuleAttributes() map[string]tfsdk.Attribute {
+	return withAttributes(firewallRuleAttributes(), map[string]tfsdk.Attribute{
+		"tcp_flags": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "A comma separated list of TCP flags to match, e.g. `SYN,!ACK`. Flags prefixed with `!` must not be set. May only be set when `protocol` is `tcp`.",
+			Validators: []tfsdk.AttributeValidator{
+				tcpFlags(),
+				requiresValue("protocol", "tcp"),
+			},
+		},
+		"icmp": {
+			Description: "The ICMP messages to match, either by `type_name` or by `type` and `code`. May only be set when `protocol` is `icmp`.",
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"type_name": {
+					Type:        types.StringType,
+					Optional:    true,
+					Description: "The name of the ICMP type, e.g. `echo-request`. Conflicts with `type` and `code`.",
+					Validators: []tfsdk.AttributeValidator{
+						validators.NoWhitespace(),
+						validators.ConflictsWith("type", "code"),
+					},
+				},
+				"type": {
+					Type:        types.NumberType,
+					Optional:    true,
+					Description: "The ICMP type. Conflicts with `type_name`.",
+					Validators: []tfsdk.AttributeValidator{
+						validators.Range(float64(0), float64(255)),
+					},
+				},
+				"code": {
+					Type:        types.NumberType,
+					Optional:    true,
+					Description: "The ICMP code. Only meaningful together with `type`.",
+					Validators: []tfsdk.AttributeValidator{
+						validators.Range(float64(0), float64(255)),
+					},
+				},
+			}),
+			Optional: true,
+			Validators: []tfsdk.AttributeValidator{
+				requiresValue("protocol", "icmp"),
+			},
+		},
+		"fragment": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "Match either only fragmented packets or only packets that are not fragmented. Must be one of `match-frag`, `match-non-frag`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.StringInSlice(true, "match-frag", "match-non-frag"),
+			},
+		},
+		"ipsec": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "Match either only traffic that was received over IPsec or only traffic that was not. Must be one of `match-ipsec`, `match-none`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.StringInSlice(true, "match-ipsec", "match-none"),
+			},
+		},
+		"limit": {
+			Description: "Match traffic only up to a given rate, e.g. to rate limit ICMP.",
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"rate": {
+					Type:        types.StringType,
+					Required:    true,
+					Description: "The maximum average rate at which traffic is matched, of the form `<number>/<unit>` where unit is one of `second`, `minute`, `hour`, `day`.",
+					Validators: []tfsdk.AttributeValidator{
+						rate(),
+					},
+				},
+				"burst": {
+					Type:        types.NumberType,
+					Optional:    true,
+					Description: "The number of packets that may be matched in a burst before `rate` applies.",
+					Validators: []tfsdk.AttributeValidator{
+						validators.Range(float64(1), float64(4294967295)),
+					},
+				},
+			}),
+			Optional: true,
+		},
+		"recent": {
+			Description: "Match traffic from hosts that were recently seen a number of times, e.g. to protect against SSH brute force attacks.",
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"count": {
+					Type:        types.NumberType,
+					Required:    true,
+					Description: "The number of times a host must have been seen.",
+					Validators: []tfsdk.AttributeValidator{
+						validators.Range(float64(1), float64(255)),
+					},
+				},
+				"time": {
+					Type:        types.NumberType,
+					Required:    true,
+					Description: "The window, in seconds, a host must have been seen `count` times within.",
+					Validators: []tfsdk.AttributeValidator{
+						validators.Range(float64(1), float64(4294967295)),
+					},
+				},
+			}),
+			Optional: true,
+		},
+		"time": {
+			Description: "Match traffic only within a period of time. All times are in the router's local time zone unless `utc` is set.",
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"start_date": {
+					Type:        types.StringType,
+					Optional:    true,
+					Description: "The date, of the form `YYYY-MM-DD`, from which on traffic is matched.",
+					Validators: []tfsdk.AttributeValidator{
+						date(),
+					},
+				},
+				"stop_date": {
+					Type:        types.StringType,
+					Optional:    true,
+					Description: "The date, of the form `YYYY-MM-DD`, after which traffic is no longer matched.",
+					Validators: []tfsdk.AttributeValidator{
+						date(),
+					},
+				},
+				"start_time": {
+					Type:        types.StringType,
+					Optional:    true,
+					Description: "The time of day, of the form `hh:mm:ss`, from which on traffic is matched.",
+					Validators: []tfsdk.AttributeValidator{
+						timeOfDay(),
+					},
+				},
+				"stop_time": {
+					Type:        types.StringType,
+					Optional:    true,
+					Description: "The time of day, of the form `hh:mm:ss`, after which traffic is no longer matched.",
+					Validators: []tfsdk.AttributeValidator{
+						timeOfDay(),
+					},
+				},
+				"weekdays": {
+					Type:        types.StringType,
+					Optional:    true,
+					Description: "A comma separated list of the days on which traffic is matched, e.g. `Mon,Tue`. If prefixed with `!`, traffic is matched on every other day.",
+					Validators: []tfsdk.AttributeValidator{
+						weekdays(),
+					},
+				},
+				"utc": {
+					Type:        types.BoolType,
+					Optional:    true,
+					Description: "Interpret dates and times as UTC.",
+				},
+			}),
+			Optional: true,
+		},
+		"priority": {
+			Type:        types.NumberType,
+			Required:    true,
+			Description: "The priority of this rule. The higher the priority, the higher the precedence.",
+		},
+		"action": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The action to take on traffic that matches this rule. Must be one of `reject`, `drop`, `accept`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.StringInSlice(true, "drop", "reject", "accept"),
+			},
+		},
+	})
+}
+
+func schemaFirewallRuleset() tfsdk.Schema {
 	return tfsdk.Schema{
+		// Version 1 turned the `rule` blocks from a set into a list. Both are
+		// encoded as a JSON array in the state, so the framework, which passes
+		// older states through unchanged, upgrades them as is; the rules are
+		// put in order of priority by the next refresh.
+		Version:     1,
 		Description: "A grouping of firewall rules. The firewall is not enforced unless attached to an interface which can be done with the `firewall_ruleset_attachment` resource.",
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
@@ -153,107 +424,25 @@ func schemaFirewallRuleset() tfsdk.Schema {
 				Optional:    true,
 				Description: "Turn on logging for this rule. These rotated logs can be found in /var/log/messages on your router.",
 			},
+			"force_detach": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Detach this ruleset from every interface it is attached to when it is deleted. Otherwise, deleting a ruleset that is still attached fails.",
+			},
+			"ignore_undeclared_rules": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Ignore the rules of this ruleset that are not declared in a `rule` block, e.g. because they are managed by `edge_firewall_rule` or generated by the `local_firewall` of an `edge_vpn_ipsec_site_to_site_peer`. Otherwise, such rules are removed.",
+			},
 		},
 		Blocks: map[string]tfsdk.Block{
 			"rule": {
+				Description: ruleBlockDescription,
 				Validators: []tfsdk.AttributeValidator{
 					validators.Unique("priority"),
 				},
-				NestingMode: tfsdk.BlockNestingModeSet,
-				Attributes: map[string]tfsdk.Attribute{
-					"priority": {
-						Type:        types.NumberType,
-						Required:    true,
-						Description: "The priority of this rule. The higher the priority, the higher the precedence.",
-					},
-					"description": {
-						Type:        types.StringType,
-						Optional:    true,
-						Description: "A human readable description for this rule.",
-						Validators: []tfsdk.AttributeValidator{
-							validators.MinLength(1),
-						},
-					},
-					"log": {
-						Type:        types.BoolType,
-						Optional:    true,
-						Description: "Turn on logging for this rule. These rotated logs can be found in /var/log/messages on your router.",
-					},
-					"action": {
-						Type:        types.StringType,
-						Required:    true,
-						Description: "The action to take on traffic that matches this rule. Must be one of `reject`, `drop`, `accept`.",
-						Validators: []tfsdk.AttributeValidator{
-							validators.StringInSlice(true, "drop", "reject", "accept"),
-						},
-					},
-					"protocol": {
-						Type:        types.StringType,
-						Optional:    true,
-						Description: "The protocol this rule applies to. If not specified, this rule applies to all protcols. Values prefixed with `!` specifies a _not_ behavior. If `!` is provided, this rule applies to all protocols except this one.",
-						Validators: []tfsdk.AttributeValidator{
-							validators.StringInSlice(true, append(
-								append(
-									protocols,
-									utils.WithPrefix("!", protocols)...,
-								),
-								"all", "*",
-							)...),
-						},
-					},
-					"state": {
-						Description: "This describes the connection state of a packet.",
-						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-							"established": {
-								Type:        types.BoolType,
-								Optional:    true,
-								Description: "Match packets that are part of a two-way connection.",
-							},
-							"new": {
-								Type:        types.BoolType,
-								Optional:    true,
-								Description: "Match packets creating a new connection.",
-							},
-							"related": {
-								Type:        types.BoolType,
-								Optional:    true,
-								Description: "Match packets related to established connections.",
-							},
-							"invalid": {
-								Type:        types.BoolType,
-								Optional:    true,
-								Description: "Match packets that cannot be identified.",
-							},
-						}),
-						Optional: true,
-					},
-					"destination": {
-						Description: "Details about the traffic's destination. If not specified, all sources will be evaluated.",
-						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-							"address":       address,
-							"port":          port,
-							"address_group": addressGroup,
-							"port_group":    portGroup,
-						}),
-						Optional: true,
-						// Need a validator to ensure address conflicts with address_group and port conflicts with port_group.
-					},
-					"source": {
-						Description: "Details about the traffic's source. If not specified, all sources will be evaluated.",
-						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-							"address":       address,
-							"port":          port,
-							"address_group": addressGroup,
-							"port_group":    portGroup,
-							"mac": {
-								Type:     types.StringType,
-								Optional: true,
-							},
-						}),
-						Optional: true,
-						// Need a validator to ensure address conflicts with address_group and port conflicts with port_group.
-					},
-				},
+				NestingMode: tfsdk.BlockNestingModeList,
+				Attributes:  firewallRulesetRuleAttributes(),
 			},
 		},
 	}
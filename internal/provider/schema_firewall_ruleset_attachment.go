@@ -18,7 +18,7 @@ func schemaFirewallRulesetAttachment() tfsdk.Schema {
 				Type:          types.StringType,
 				Required:      true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
-				Description:   "The interface to attach firewall rules to.",
+				Description:   "The interface to attach firewall rules to. Ethernet (`eth0`), bridge (`br0`) and switch (`switch0`) interfaces are supported, as well as their VLAN interfaces (`switch0.10`).",
 			},
 			"in": {
 				Type:        types.StringType,
@@ -35,6 +35,22 @@ func schemaFirewallRulesetAttachment() tfsdk.Schema {
 				Optional:    true,
 				Description: "Match local packets.",
 			},
+			"modify": {
+				Description: "Attach modify rulesets, which are managed by the `edge_firewall_modify_ruleset` resource.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"in": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "Modify inbound packets.",
+					},
+					"out": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "Modify outbound packets.",
+					},
+				}),
+				Optional: true,
+			},
 		},
 	}
 }
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaInterfaceBridge() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A bridge interface that switches traffic between its member interfaces in software. Firewall rulesets can be attached to it like to any other interface.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The name of the bridge, e.g. `br0`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this bridge.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"addresses": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The addresses of the bridge in cidr notation, or `dhcp` to obtain one from a DHCP server.",
+			},
+			"members": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The ethernet interfaces that are part of the bridge. An interface can only be part of one bridge.",
+			},
+			"stp": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Run the spanning tree protocol to prevent loops.",
+			},
+			"priority": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The spanning tree priority of the bridge. The bridge with the lowest priority becomes the root.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(0), float64(65535)),
+				},
+			},
+			"aging": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The number of seconds after which learned MAC addresses are forgotten.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(0), float64(86400)),
+				},
+			},
+			"hello_time": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The number of seconds between spanning tree hello packets.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(10)),
+				},
+			},
+			"forwarding_delay": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The number of seconds spent in the listening and learning states before forwarding.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(0), float64(200)),
+				},
+			},
+			"max_age": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The number of seconds after which spanning tree information is considered stale.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(40)),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaInterfaceSwitch() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A switch interface that switches traffic between its ports in hardware, e.g. `switch0` of an EdgeRouter X. Firewall rulesets can be attached to it like to any other interface.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The name of the switch, e.g. `switch0`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this switch.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"addresses": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The addresses of the switch in cidr notation, or `dhcp` to obtain one from a DHCP server. These apply to untagged traffic.",
+			},
+			"vlan_aware": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Switch tagged traffic according to the `pvid` and `vids` of the ports.",
+			},
+			"ports": {
+				Description: "The ethernet interfaces that are ports of the switch, keyed by interface name.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"pvid": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "The VLAN of the untagged traffic of the port. Only used when `vlan_aware` is `true`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(1), float64(4094)),
+						},
+					},
+					"vids": {
+						Type:        types.SetType{ElemType: types.NumberType},
+						Optional:    true,
+						Description: "The VLANs of the tagged traffic of the port. Only used when `vlan_aware` is `true`.",
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"vifs": {
+				Description: "The VLAN interfaces of the switch, keyed by VLAN id.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"description": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "A human readable description for this VLAN interface.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(1),
+						},
+					},
+					"addresses": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The addresses of the VLAN interface in cidr notation, or `dhcp` to obtain one from a DHCP server.",
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaLoadBalanceGroup() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A WAN load balancing group that distributes or fails over traffic between interfaces. Traffic is balanced with this group when a rule of an `edge_firewall_modify_ruleset` sets `modify.lb_group` to its name.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A unique, human readable name for this load balancing group.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"interfaces": {
+				Description: "The interfaces to balance traffic between, keyed by interface name.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"weight": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "The share of the traffic to send through this interface, relative to the other interfaces.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(1), float64(100)),
+						},
+					},
+					"failover_only": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Only send traffic through this interface when all other interfaces are down.",
+					},
+					"route_test": {
+						Description: "How to test whether the interface is up.",
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"type": {
+								Type:        types.StringType,
+								Required:    true,
+								Description: "The kind of test. Must be one of `default`, `ping`, `script`. `default` pings the default gateway of the interface.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.StringInSlice(true, "default", "ping", "script"),
+								},
+							},
+							"target": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The address to ping. Only used when `type` is `ping`.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+							"script": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The path of the script to run. Only used when `type` is `script`.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+							"interval": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "The number of seconds between tests.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(1), float64(86400)),
+								},
+							},
+							"success_count": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "The number of consecutive successful tests after which the interface is considered up.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(1), float64(10)),
+								},
+							},
+							"failure_count": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "The number of consecutive failed tests after which the interface is considered down.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(1), float64(10)),
+								},
+							},
+						}),
+						Optional: true,
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Required: true,
+			},
+			"sticky": {
+				Description: "Send the packets of a flow through the same interface. A flow is identified by the enabled fields.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"source_address": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Identify flows by source address.",
+					},
+					"destination_address": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Identify flows by destination address.",
+					},
+					"source_port": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Identify flows by source port.",
+					},
+					"destination_port": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Identify flows by destination port.",
+					},
+					"protocol": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Identify flows by protocol.",
+					},
+				}),
+				Optional: true,
+			},
+			"lb_local": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Balance traffic that originates from the router itself.",
+			},
+			"lb_local_metric_change": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Change the metric of the default routes of the router when an interface goes down. Only used when `lb_local` is `true`.",
+			},
+			"flush_on_active": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Flush the connection tracking table when an interface becomes active.",
+			},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaPrefixList() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "An ordered list of prefixes used to filter routes, e.g. by `edge_bgp_neighbor` or `edge_route_map`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A unique, human readable name for this prefix list.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this prefix list.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"rules": {
+				Description: "The rules of the prefix list, keyed by rule number. Rules are evaluated in ascending order and the first match wins. Routes that match no rule are denied.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"action": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The action to take on routes that match this rule. Must be one of `permit`, `deny`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "permit", "deny"),
+						},
+					},
+					"prefix": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The prefix to match, in cidr notation.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Cidr(),
+						},
+					},
+					"description": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "A human readable description for this rule.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(1),
+						},
+					},
+					"ge": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "Also match longer prefixes whose length is greater than or equal to this value.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(0), float64(32)),
+						},
+					},
+					"le": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "Also match longer prefixes whose length is less than or equal to this value.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(0), float64(32)),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Required: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func bgpRedistributionAttribute(protocol string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Redistribute " + protocol + " routes into BGP. Set to `{}` to redistribute them with the default settings.",
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"metric": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The multi-exit discriminator of the redistributed routes.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(0), float64(4294967295)),
+				},
+			},
+			"route_map": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of a route map that filters or modifies the redistributed routes.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+		}),
+		Optional: true,
+	}
+}
+
+func schemaProtocolsBGP() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The BGP process of the router. There is only one per router. Its neighbors are managed with `edge_bgp_neighbor`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the AS number. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"asn": {
+				Type:          types.NumberType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The local AS number.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(4294967295)),
+				},
+			},
+			"router_id": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The router id, in the form of an IPv4 address. If not specified, it is chosen from the addresses of the router.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"networks": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The networks, in cidr notation, to announce to the neighbors.",
+			},
+			"redistribute": {
+				Description: "The routes of other sources to announce through BGP.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"connected": bgpRedistributionAttribute("connected"),
+					"kernel":    bgpRedistributionAttribute("kernel"),
+					"ospf":      bgpRedistributionAttribute("OSPF"),
+					"static":    bgpRedistributionAttribute("static"),
+				}),
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaProtocolsIGMPProxy() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The IGMP proxy, which forwards multicast traffic such as IPTV from an upstream interface to downstream interfaces. There is only one per router; it can be imported with the id `igmp-proxy`. Deleting this resource disables the proxy.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `igmp-proxy`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"interfaces": {
+				Description: "The interfaces of the proxy, keyed by interface name. Exactly one interface must be `upstream`.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"role": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The role of the interface. Must be one of `upstream`, `downstream`, `disabled`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "upstream", "downstream", "disabled"),
+						},
+					},
+					"alt_subnets": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The cidrs of multicast sources that are not on the network of the interface. Typically used on the `upstream` interface.",
+					},
+					"threshold": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "The minimum TTL of multicast packets forwarded through the interface.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(1), float64(255)),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Required: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ospfRedistributionAttribute(protocol string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Redistribute " + protocol + " routes into OSPF. Set to `{}` to redistribute them with the default settings.",
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"metric": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The metric of the redistributed routes.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(0), float64(16777214)),
+				},
+			},
+			"metric_type": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The type of external metric of the redistributed routes. Must be one of `1`, `2`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(2)),
+				},
+			},
+			"route_map": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of a route map that filters or modifies the redistributed routes.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+		}),
+		Optional: true,
+	}
+}
+
+func schemaProtocolsOSPF() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The OSPF process of the router. There is only one per router; it can be imported with the id `ospf`. Deleting this resource stops OSPF.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `ospf`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"areas": {
+				Description: "The areas the router takes part in, keyed by area id, e.g. `0` or `0.0.0.0` for the backbone.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"type": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The type of the area. Must be one of `normal`, `stub`, `nssa`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "normal", "stub", "nssa"),
+						},
+					},
+					"networks": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Required:    true,
+						Description: "The networks, in cidr notation, whose interfaces take part in this area.",
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Required: true,
+			},
+			"passive_interfaces": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The interfaces whose networks are announced but on which no adjacencies are formed. Use `default` to make all interfaces passive.",
+			},
+			"redistribute": {
+				Description: "The routes of other sources to announce through OSPF.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"bgp":       ospfRedistributionAttribute("BGP"),
+					"connected": ospfRedistributionAttribute("connected"),
+					"kernel":    ospfRedistributionAttribute("kernel"),
+					"static":    ospfRedistributionAttribute("static"),
+				}),
+				Optional: true,
+			},
+			"parameters": {
+				Description: "The parameters of the OSPF process.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"router_id": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The router id, in the form of an IPv4 address. If not specified, it is chosen from the addresses of the router.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.NoWhitespace(),
+						},
+					},
+					"abr_type": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The area border router behaviour. Must be one of `cisco`, `ibm`, `shortcut`, `standard`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "cisco", "ibm", "shortcut", "standard"),
+						},
+					},
+					"rfc1583_compatibility": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Select routes to external destinations as described in RFC 1583.",
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+}
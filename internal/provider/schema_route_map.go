@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaRouteMap() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "An ordered list of rules that filter and modify routes, e.g. for `edge_bgp_neighbor` or route redistribution.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A unique, human readable name for this route map.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this route map.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"rules": {
+				Description: "The rules of the route map, keyed by rule number. Rules are evaluated in ascending order and the first match wins. Routes that match no rule are denied.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"action": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The action to take on routes that match this rule. Must be one of `permit`, `deny`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "permit", "deny"),
+						},
+					},
+					"description": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "A human readable description for this rule.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(1),
+						},
+					},
+					"match": {
+						Description: "The criteria a route must meet to match this rule. If not specified, all routes are matched.",
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"prefix_list": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The name of a prefix list that permits the prefix of the route.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+							"as_path": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The name of an AS path list that permits the AS path of the route.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+							"interface": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The interface of the next hop of the route.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+							"metric": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "The metric of the route.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(0), float64(4294967295)),
+								},
+							},
+						}),
+						Optional: true,
+					},
+					"set": {
+						Description: "How to modify the routes that match this rule. Only used when `action` is `permit`.",
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"local_preference": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "The BGP local preference.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(0), float64(4294967295)),
+								},
+							},
+							"metric": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "The metric, or the multi-exit discriminator for BGP.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(0), float64(4294967295)),
+								},
+							},
+							"weight": {
+								Type:        types.NumberType,
+								Optional:    true,
+								Description: "The BGP weight. It is only significant to this router.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.Range(float64(0), float64(4294967295)),
+								},
+							},
+							"as_path_prepend": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The space separated AS numbers to prepend to the AS path.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.MinLength(1),
+								},
+							},
+							"community": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The BGP community, e.g. `65000:100` or `no-export`.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.MinLength(1),
+								},
+							},
+							"next_hop": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The address of the next hop.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.NoWhitespace(),
+								},
+							},
+							"origin": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "The BGP origin. Must be one of `igp`, `egp`, `incomplete`.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.StringInSlice(true, "igp", "egp", "incomplete"),
+								},
+							},
+						}),
+						Optional: true,
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Required: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaServiceDNSDynamic() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "Registers the address of an interface with a dynamic DNS service.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource, of the form `<interface>/<service>`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"interface": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The interface whose address is registered.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"service": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The dynamic DNS service, e.g. `dyndns`, `afraid`, `namecheap` or `noip`. Services that are not built in must be prefixed with `custom-` and specify `protocol` and `server`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"host_names": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The host names to register.",
+			},
+			"login": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The user name of the account with the service.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"password": {
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the account with the service.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"server": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The host name of the server of the service. If not specified, the default server of a built in service is used.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"protocol": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The update protocol of a `custom-` service, e.g. `dyndns2`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+		},
+	}
+}
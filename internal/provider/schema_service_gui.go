@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaServiceGUI() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The web interface, which is also what the provider connects to. There is only one per router; it can be imported with the id `gui`. Attributes that are not set are removed from the configuration and deleting this resource restores the defaults. A warning is shown when a change would break the connection of the provider.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `gui`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"http_port": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The HTTP port to listen on. Defaults to 80.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(65535)),
+				},
+			},
+			"https_port": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The HTTPS port to listen on. Defaults to 443.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(65535)),
+				},
+			},
+			"listen_address": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The local address to listen on. If not specified, the web interface listens on all addresses.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"older_ciphers": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Accept older TLS ciphers.",
+			},
+			"cert_file": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The path of a PEM file on the router containing the certificate and private key to use.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaServiceMDNSRepeater() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The mDNS repeater, which forwards multicast DNS announcements between interfaces so that services can be discovered across subnets. There is only one per router; it can be imported with the id `mdns-repeater`. Deleting this resource disables the repeater.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `mdns-repeater`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"interfaces": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The interfaces to repeat announcements between. At least two are required.",
+			},
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaServiceSNMP() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The SNMP service. There is only one per router; it can be imported with the id `snmp`. Communities and users that are not declared are removed from the configuration and deleting this resource disables the service.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `snmp`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"contact": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The contact information reported by the router.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"location": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The location reported by the router.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"listen_addresses": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The local addresses to listen on. If not specified, the service listens on all addresses.",
+			},
+			"communities": {
+				Description: "SNMPv1 and SNMPv2c communities, keyed by name.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"authorization": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The access granted to the community. Must be one of `ro`, `rw`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "ro", "rw"),
+						},
+					},
+					"clients": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The addresses of the clients that may use this community.",
+					},
+					"networks": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The subnets of the clients that may use this community.",
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"v3_users": {
+				Description: "SNMPv3 users, keyed by name.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"mode": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The access granted to the user. Must be one of `ro`, `rw`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "ro", "rw"),
+						},
+					},
+					"auth_type": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The authentication protocol. Must be one of `md5`, `sha`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "md5", "sha"),
+						},
+					},
+					"auth_key": {
+						Type:        types.StringType,
+						Required:    true,
+						Sensitive:   true,
+						Description: "The authentication passphrase. The router only stores it encrypted, hence changes made outside of terraform are not detected.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(8),
+						},
+					},
+					"privacy_type": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The privacy protocol. Must be one of `des`, `aes`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "des", "aes"),
+						},
+					},
+					"privacy_key": {
+						Type:        types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The privacy passphrase. The router only stores it encrypted, hence changes made outside of terraform are not detected.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(8),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaServiceSSH() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The SSH service. There is only one per router; it can be imported with the id `ssh`. Attributes that are not set are removed from the configuration and deleting this resource restores the defaults rather than disabling the service.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `ssh`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"port": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The port to listen on. Defaults to 22.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(65535)),
+				},
+			},
+			"listen_addresses": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The local addresses to listen on. If not specified, the service listens on all addresses.",
+			},
+			"protocol_version": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The SSH protocol version. Must be one of `v1`, `v2`, `all`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true, "v1", "v2", "all"),
+				},
+			},
+			"disable_password_authentication": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Only allow public key authentication.",
+			},
+			"allow_root": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Allow the root user to log in.",
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaServiceUPnP2() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The UPnP IGD and NAT-PMP service, which lets hosts on the LAN open ports on the WAN interface. There is only one per router; it can be imported with the id `upnp2`. Deleting this resource disables the service.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `upnp2`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"wan_interface": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The WAN interface on which ports are opened.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"listen_on": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Required:    true,
+				Description: "The LAN interfaces on which requests are accepted.",
+			},
+			"nat_pmp": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Accept NAT-PMP requests in addition to UPnP requests.",
+			},
+			"secure_mode": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Only allow hosts to open ports that are forwarded to themselves.",
+			},
+			"acl": {
+				Description: "The rules that decide which requests are granted, keyed by rule number. Rules are evaluated in ascending order and the first match wins.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"action": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The action to take on requests that match this rule. Must be one of `allow`, `deny`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "allow", "deny"),
+						},
+					},
+					"description": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "A human readable description for this rule.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(1),
+						},
+					},
+					"subnet": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The cidr of the hosts this rule applies to.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Cidr(),
+						},
+					},
+					"local_port": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The port or range of ports of the hosts this rule applies to, e.g. `1024-65535`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.NoWhitespace(),
+						},
+					},
+					"external_port": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The port or range of ports on the WAN interface this rule applies to, e.g. `1024-65535`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.NoWhitespace(),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+}
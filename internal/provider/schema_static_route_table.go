@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaStaticRouteTable() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "An alternate routing table for policy-based routing. Traffic is routed with this table when a rule of an `edge_firewall_modify_ruleset` sets `modify.table` to its number.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the table number. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"table": {
+				Type:          types.NumberType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The number of the routing table.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(250)),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this table.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"routes": {
+				Description: "Routes through a gateway, keyed by destination cidr.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"next_hops": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "The addresses of the gateways. Conflicts with `blackhole`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.ConflictsWith("blackhole"),
+						},
+					},
+					"blackhole": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Silently drop the traffic. Conflicts with `next_hops`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.ConflictsWith("next_hops"),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"interface_routes": {
+				Description: "Routes through an interface, keyed by destination cidr.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"interface": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The interface to send the traffic to, e.g. `pppoe0`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.NoWhitespace(),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+}
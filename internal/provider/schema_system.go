@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaOffloadFamily(family string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Hardware offloading of " + family + " traffic.",
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"forwarding": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Offload forwarding.",
+			},
+			"vlan": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Offload VLAN traffic.",
+			},
+			"pppoe": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Offload PPPoE traffic.",
+			},
+		}),
+		Optional: true,
+	}
+}
+
+func schemaSystem() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The basic system settings of the router. There is only one set per router; it can be imported with the id `system`. Attributes that are not set are removed from the configuration and deleting this resource restores the factory defaults. Settings this resource does not model, such as `offload hwnat`, are left alone.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `system`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"host_name": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The host name of the router.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+					validators.MinLength(1),
+				},
+			},
+			"domain_name": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The domain name of the router.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+					validators.MinLength(1),
+				},
+			},
+			"time_zone": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The time zone of the router, e.g. `America/Los_Angeles`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+					validators.MinLength(1),
+				},
+			},
+			"ntp_servers": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The NTP servers to synchronize the clock with.",
+			},
+			"name_servers": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The DNS servers the router itself uses, in order of preference.",
+			},
+			"offload": {
+				Description: "Hardware offloading settings. Which settings are supported depends on the model of the router.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"ipv4": schemaOffloadFamily("IPv4"),
+					"ipv6": schemaOffloadFamily("IPv6"),
+					"ipsec": {
+						Type:        types.BoolType,
+						Optional:    true,
+						Description: "Offload IPsec encryption.",
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func conntrackTimeoutAttribute(description string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:        types.NumberType,
+		Optional:    true,
+		Description: description,
+		Validators: []tfsdk.AttributeValidator{
+			validators.Range(float64(1), float64(21474836)),
+		},
+	}
+}
+
+func conntrackModuleAttribute(protocol string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:        types.BoolType,
+		Optional:    true,
+		Description: "Track the related connections of " + protocol + ". Helpers are enabled unless set to `false`.",
+	}
+}
+
+func schemaSystemConntrack() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The connection tracking settings of the router, which the `state` of firewall rules relies on. There is only one set per router; it can be imported with the id `conntrack`. Attributes that are not set are removed from the configuration and deleting this resource restores the factory defaults.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `conntrack`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"table_size": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The maximum number of tracked connections.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(50000000)),
+				},
+			},
+			"expect_table_size": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The maximum number of expected connections, i.e. related connections announced by a helper.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(50000000)),
+				},
+			},
+			"hash_size": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The size of the hash table of tracked connections. Changes only take effect after a reboot.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(50000000)),
+				},
+			},
+			"timeouts": {
+				Description: "The number of seconds after which idle connections are forgotten.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"icmp":  conntrackTimeoutAttribute("The timeout of ICMP connections."),
+					"other": conntrackTimeoutAttribute("The timeout of connections of other protocols."),
+					"tcp": {
+						Description: "The timeouts of TCP connections per state.",
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"close":       conntrackTimeoutAttribute("The timeout of connections in the CLOSE state."),
+							"close_wait":  conntrackTimeoutAttribute("The timeout of connections in the CLOSE-WAIT state."),
+							"established": conntrackTimeoutAttribute("The timeout of connections in the ESTABLISHED state."),
+							"fin_wait":    conntrackTimeoutAttribute("The timeout of connections in the FIN-WAIT state."),
+							"last_ack":    conntrackTimeoutAttribute("The timeout of connections in the LAST-ACK state."),
+							"syn_recv":    conntrackTimeoutAttribute("The timeout of connections in the SYN-RECV state."),
+							"syn_sent":    conntrackTimeoutAttribute("The timeout of connections in the SYN-SENT state."),
+							"time_wait":   conntrackTimeoutAttribute("The timeout of connections in the TIME-WAIT state."),
+						}),
+						Optional: true,
+					},
+					"udp": {
+						Description: "The timeouts of UDP connections.",
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"other":  conntrackTimeoutAttribute("The timeout of UDP connections that have seen traffic in one direction only."),
+							"stream": conntrackTimeoutAttribute("The timeout of UDP connections that have seen traffic in both directions."),
+						}),
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+			"modules": {
+				Description: "The helpers that track the related connections of protocols such as FTP or SIP.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"ftp":  conntrackModuleAttribute("FTP"),
+					"gre":  conntrackModuleAttribute("GRE"),
+					"h323": conntrackModuleAttribute("H.323"),
+					"pptp": conntrackModuleAttribute("PPTP"),
+					"sip":  conntrackModuleAttribute("SIP"),
+					"tftp": conntrackModuleAttribute("TFTP"),
+				}),
+				Optional: true,
+			},
+		},
+	}
+}
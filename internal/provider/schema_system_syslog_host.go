@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaSystemSyslogHost() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A remote host that log messages are sent to. It can be imported with the id `<host>` or `<host>:<port>`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the host, followed by a colon and the port if one is set.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"host": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The address or hostname of the collector.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"port": {
+				Type:          types.NumberType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The UDP port of the collector. Defaults to 514.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(1), float64(65535)),
+				},
+			},
+			"facility": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The facility of the messages to send. Use `all` to send messages of every facility.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true,
+						"all",
+						"auth",
+						"authpriv",
+						"cron",
+						"daemon",
+						"kern",
+						"lpr",
+						"mail",
+						"mark",
+						"news",
+						"protocols",
+						"security",
+						"syslog",
+						"user",
+						"uucp",
+						"local0",
+						"local1",
+						"local2",
+						"local3",
+						"local4",
+						"local5",
+						"local6",
+						"local7",
+					),
+				},
+			},
+			"level": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The minimum severity of the messages to send. Must be one of `emerg`, `alert`, `crit`, `err`, `warning`, `notice`, `info`, `debug`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true, "emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaSystemUser() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A user that can log in to the router. The user the provider is logged in as cannot be deleted.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The username.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"full_name": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The full name of the user.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"level": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The privilege level of the user. Must be one of `admin`, `operator`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true, "admin", "operator"),
+				},
+			},
+			"password": {
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The plaintext password of the user. The router only stores its hash, hence changes made outside of terraform are not detected.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+					validators.ConflictsWith("encrypted_password"),
+				},
+			},
+			"encrypted_password": {
+				Type:        types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the user hashed with `crypt(3)`, e.g. the output of `mkpasswd -m sha-512`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+					validators.ConflictsWith("password"),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaSystemUserSSHKey() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "An SSH public key that a user can log in with. It can be imported with the id `<user>/<name>`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be `<user>/<name>`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"user": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The name of the user this key belongs to.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A name for this key that is unique for the user, typically the comment of the key such as `alice@laptop`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"type": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The type of the key. Must be one of `ssh-rsa`, `ssh-dss`, `ssh-ed25519`, `ecdsa-sha2-nistp256`, `ecdsa-sha2-nistp384`, `ecdsa-sha2-nistp521`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true,
+						"ssh-rsa",
+						"ssh-dss",
+						"ssh-ed25519",
+						"ecdsa-sha2-nistp256",
+						"ecdsa-sha2-nistp384",
+						"ecdsa-sha2-nistp521",
+					),
+				},
+			},
+			"key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The base64 encoded public key, without the type and comment.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"options": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Options as found in `authorized_keys`, e.g. `from=\"192.168.1.0/24\"`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func smartQueueDirectionAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"rate": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The rate to shape the traffic to, e.g. `95mbit`. It should be slightly lower than the rate of the link so that the queue is kept on the router.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.NoWhitespace(),
+			},
+		},
+		"burst": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The amount of traffic that may be sent at the rate of the link before shaping starts, e.g. `15k`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.NoWhitespace(),
+			},
+		},
+		"ecn": {
+			Type:        types.BoolType,
+			Optional:    true,
+			Description: "Mark packets with explicit congestion notification rather than dropping them.",
+		},
+		"flows": {
+			Type:        types.NumberType,
+			Optional:    true,
+			Description: "The number of flows the fq-codel queue distinguishes.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.Range(float64(1), float64(65535)),
+			},
+		},
+		"fq_quantum": {
+			Type:        types.NumberType,
+			Optional:    true,
+			Description: "The number of bytes the fq-codel queue dequeues from a flow at a time.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.Range(float64(1), float64(1048576)),
+			},
+		},
+		"htb_quantum": {
+			Type:        types.NumberType,
+			Optional:    true,
+			Description: "The number of bytes the htb shaper dequeues at a time.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.Range(float64(1), float64(1048576)),
+			},
+		},
+		"interval": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The interval over which the fq-codel queue measures the delay, e.g. `100ms`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.NoWhitespace(),
+			},
+		},
+		"limit": {
+			Type:        types.NumberType,
+			Optional:    true,
+			Description: "The maximum number of packets in the fq-codel queue.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.Range(float64(1), float64(1048576)),
+			},
+		},
+		"target": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The delay the fq-codel queue aims for, e.g. `5ms`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.NoWhitespace(),
+			},
+		},
+	}
+}
+
+func schemaTrafficControlSmartQueue() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "Smart queue management of the traffic of a WAN interface. The traffic is shaped slightly below the rate of the link and queued with fq-codel to keep latency low under load.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A unique, human readable name for this smart queue policy.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"wan_interface": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The WAN interface to manage the traffic of.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"download": {
+				Description: "The settings for the traffic received on the WAN interface.",
+				Attributes:  tfsdk.SingleNestedAttributes(smartQueueDirectionAttributes()),
+				Optional:    true,
+			},
+			"upload": {
+				Description: "The settings for the traffic sent on the WAN interface.",
+				Attributes:  tfsdk.SingleNestedAttributes(smartQueueDirectionAttributes()),
+				Optional:    true,
+			},
+		},
+	}
+}
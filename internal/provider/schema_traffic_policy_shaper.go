@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// shaperClassAttributes returns the attributes that describe how the traffic
+// of a class is shaped. They are shared by the default class.
+func shaperClassAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"bandwidth": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The bandwidth guaranteed to this class, either as a rate such as `10mbit` or as a percentage of the bandwidth of the shaper such as `50%`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.NoWhitespace(),
+			},
+		},
+		"ceiling": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The bandwidth this class may borrow up to when other classes are idle, either as a rate or as a percentage.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.NoWhitespace(),
+			},
+		},
+		"burst": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The amount of traffic that may be sent at the ceiling before shaping starts, e.g. `15k`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.NoWhitespace(),
+			},
+		},
+		"priority": {
+			Type:        types.NumberType,
+			Optional:    true,
+			Description: "The priority of this class when borrowing bandwidth. The lower the number, the higher the precedence.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.Range(float64(0), float64(7)),
+			},
+		},
+		"queue_type": {
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "The queueing discipline of this class. Must be one of `fair-queue`, `fq-codel`, `drop-tail`, `priority`, `random-detect`.",
+			Validators: []tfsdk.AttributeValidator{
+				validators.StringInSlice(true, "fair-queue", "fq-codel", "drop-tail", "priority", "random-detect"),
+			},
+		},
+	}
+}
+
+func schemaTrafficPolicyShaper() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A traffic shaper that divides the bandwidth of an interface between classes of traffic.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A unique, human readable name for this shaper.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this shaper.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"bandwidth": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The total bandwidth of the shaper, e.g. `100mbit`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"default": {
+				Description: "The class of the traffic that is not matched by any other class.",
+				Attributes:  tfsdk.SingleNestedAttributes(shaperClassAttributes()),
+				Required:    true,
+			},
+			"classes": {
+				Description: "The classes of traffic, keyed by class number between 2 and 4095.",
+				Attributes: tfsdk.MapNestedAttributes(withAttributes(shaperClassAttributes(), map[string]tfsdk.Attribute{
+					"description": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "A human readable description for this class.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(1),
+						},
+					},
+					"match": {
+						Description: "The criteria that assign traffic to this class, keyed by name. Traffic that matches any of them belongs to this class.",
+						Attributes: tfsdk.MapNestedAttributes(withAttributes(firewallMatchAttributes(), map[string]tfsdk.Attribute{
+							"description": {
+								Type:        types.StringType,
+								Optional:    true,
+								Description: "A human readable description for this match.",
+								Validators: []tfsdk.AttributeValidator{
+									validators.MinLength(1),
+								},
+							},
+						}), tfsdk.MapNestedAttributesOptions{}),
+						Required: true,
+					},
+				}), tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaVPNIPsecESPGroup() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A named set of ESP (phase 2) parameters used to protect the traffic of an IPsec tunnel.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A unique, human readable name for this ESP group.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"compression": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Compress the payload of ESP packets.",
+			},
+			"lifetime": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The lifetime of the ESP security association in seconds.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(30), float64(86400)),
+				},
+			},
+			"mode": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The ESP mode. Must be one of `tunnel`, `transport`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true, "tunnel", "transport"),
+				},
+			},
+			"pfs": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Perfect forward secrecy. Must be one of `enable`, `disable` or a Diffie-Hellman group such as `dh-group14`. `enable` uses the Diffie-Hellman group negotiated by IKE.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true,
+						"enable",
+						"disable",
+						"dh-group2",
+						"dh-group5",
+						"dh-group14",
+						"dh-group15",
+						"dh-group16",
+						"dh-group17",
+						"dh-group18",
+						"dh-group19",
+						"dh-group20",
+						"dh-group21",
+						"dh-group22",
+						"dh-group23",
+						"dh-group24",
+						"dh-group25",
+						"dh-group26",
+					),
+				},
+			},
+			"proposal": {
+				Description: "An ordered list of proposals. The first proposal has the highest precedence.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"encryption": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The encryption algorithm. Must be one of `3des`, `aes128`, `aes256`, `aes128gcm128`, `aes256gcm128`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, ipsecEncryptionAlgorithms...),
+						},
+					},
+					"hash": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The hash algorithm. Must be one of `md5`, `sha1`, `sha256`, `sha384`, `sha512`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, ipsecHashAlgorithms...),
+						},
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+				Required: true,
+			},
+		},
+	}
+}
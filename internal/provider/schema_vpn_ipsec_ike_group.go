@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	ipsecEncryptionAlgorithms = []string{
+		"3des",
+		"aes128",
+		"aes256",
+		"aes128gcm128",
+		"aes256gcm128",
+	}
+
+	ipsecHashAlgorithms = []string{
+		"md5",
+		"sha1",
+		"sha256",
+		"sha384",
+		"sha512",
+	}
+)
+
+func schemaVPNIPsecIKEGroup() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "A named set of IKE (phase 1) parameters used to establish IPsec security associations with a peer.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the name. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "A unique, human readable name for this IKE group.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"key_exchange": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The IKE version to use. Must be one of `ikev1`, `ikev2`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true, "ikev1", "ikev2"),
+				},
+			},
+			"lifetime": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The lifetime of the IKE security association in seconds.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(30), float64(86400)),
+				},
+			},
+			"dead_peer_detection": {
+				Description: "Detect peers that are no longer reachable.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"action": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The action to take when a peer is detected to be dead. Must be one of `hold`, `clear`, `restart`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, "hold", "clear", "restart"),
+						},
+					},
+					"interval": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "The number of seconds between keepalive requests.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(15), float64(86400)),
+						},
+					},
+					"timeout": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "The number of seconds without a response after which the peer is considered dead.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(30), float64(86400)),
+						},
+					},
+				}),
+				Optional: true,
+			},
+			"proposal": {
+				Description: "An ordered list of proposals. The first proposal has the highest precedence.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"encryption": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The encryption algorithm. Must be one of `3des`, `aes128`, `aes256`, `aes128gcm128`, `aes256gcm128`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, ipsecEncryptionAlgorithms...),
+						},
+					},
+					"hash": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The hash algorithm. Must be one of `md5`, `sha1`, `sha256`, `sha384`, `sha512`.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, ipsecHashAlgorithms...),
+						},
+					},
+					"dh_group": {
+						Type:        types.NumberType,
+						Optional:    true,
+						Description: "The Diffie-Hellman group.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(1), float64(32)),
+						},
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+				Required: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaVPNIPsecSiteToSitePeer() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "An IPsec site-to-site VPN peer authenticated with a pre-shared secret.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "The identifier of the resource. This will always be the peer. It is present only for legacy purposes.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"peer": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Description:   "The address or hostname of the remote peer. Use `0.0.0.0` to accept connections from any address.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"description": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A human readable description for this peer.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"pre_shared_secret": {
+				Type:        types.StringType,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret shared with the peer that is used for authentication.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"local_address": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The local address to use for this connection. Use `any` to let the router choose.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"connection_type": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Whether this router initiates the connection or only responds to the peer. Must be one of `initiate`, `respond`.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSlice(true, "initiate", "respond"),
+				},
+			},
+			"ike_group": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The name of the IKE group to use with this peer.",
+			},
+			"default_esp_group": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the ESP group to use for tunnels that do not specify one.",
+			},
+			"tunnel": {
+				Description: "The tunnels to establish with this peer, keyed by tunnel number.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"esp_group": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "The name of the ESP group to use for this tunnel. Defaults to `default_esp_group`.",
+					},
+					"local_prefix": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The local subnet that is reachable through this tunnel.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Cidr(),
+						},
+					},
+					"remote_prefix": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The remote subnet that is reachable through this tunnel.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Cidr(),
+						},
+					},
+					"protocol": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "Only tunnel traffic of this protocol. If not specified, all protocols are tunneled.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSlice(true, append(protocols, "all")...),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Required: true,
+			},
+			"local_firewall": {
+				Description: "Generate the firewall rules that accept IKE (UDP 500), NAT-T (UDP 4500) and ESP traffic from this peer in an existing ruleset, typically the one attached to the `local` traffic of the WAN interface. Three consecutive rules starting at `priority` are created and removed alongside the peer; their priorities must not be taken by other rules. If the ruleset is managed by `edge_firewall_ruleset`, it requires `ignore_undeclared_rules = true` so that the generated rules are not removed.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"ruleset": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The name of the ruleset to add the rules to.",
+					},
+					"priority": {
+						Type:        types.NumberType,
+						Required:    true,
+						Description: "The priority of the first generated rule. The next two priorities must be free as well.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.Range(float64(1), float64(9997)),
+						},
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+}
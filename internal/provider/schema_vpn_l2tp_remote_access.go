@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"github.com/frankgreco/terraform-helpers/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func schemaVPNL2TPRemoteAccess() tfsdk.Schema {
+	return tfsdk.Schema{
+		Description: "The L2TP over IPsec remote access server. There is only one per router; it can be imported with the id `l2tp-remote-access`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description:   "The identifier of the resource. This will always be `l2tp-remote-access`.",
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.UseStateForUnknown()},
+			},
+			"outside_address": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The address that clients connect to.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.NoWhitespace(),
+				},
+			},
+			"client_ip_pool": {
+				Description: "The range of addresses that are assigned to clients.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"start": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The first address of the pool.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.NoWhitespace(),
+						},
+					},
+					"stop": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "The last address of the pool.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.NoWhitespace(),
+						},
+					},
+				}),
+				Optional: true,
+			},
+			"dns_servers": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Up to two DNS servers that are pushed to clients.",
+				Validators: []tfsdk.AttributeValidator{
+					maxItems(2),
+				},
+			},
+			"pre_shared_secret": {
+				Type:        types.StringType,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The IPsec secret that clients use to establish the tunnel.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"local_users": {
+				Description: "Users that are authenticated by the router, keyed by username.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"password": {
+						Type:        types.StringType,
+						Required:    true,
+						Sensitive:   true,
+						Description: "The password of the user.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(1),
+						},
+					},
+					"static_ip": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "An address that is always assigned to this user.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.NoWhitespace(),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.ConflictsWith("radius_servers"),
+				},
+			},
+			"radius_servers": {
+				Description: "RADIUS servers that authenticate users, keyed by address.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"key": {
+						Type:        types.StringType,
+						Required:    true,
+						Sensitive:   true,
+						Description: "The secret shared with the RADIUS server.",
+						Validators: []tfsdk.AttributeValidator{
+							validators.MinLength(1),
+						},
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.ConflictsWith("local_users"),
+				},
+			},
+			"mtu": {
+				Type:        types.NumberType,
+				Optional:    true,
+				Description: "The maximum transmission unit of client sessions.",
+				Validators: []tfsdk.AttributeValidator{
+					validators.Range(float64(128), float64(16384)),
+				},
+			},
+		},
+	}
+}
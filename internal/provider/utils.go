@@ -1,5 +1,53 @@
 package provider
 
+import (
+	"strings"
+
+	"terraform-provider-edge/internal/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
 func strptr(s string) *string {
 	return &s
 }
+
+func intptr(i int) *int {
+	return &i
+}
+
+func togglePtr(b bool) *types.Toggle {
+	t := types.Toggle(b)
+	return &t
+}
+
+// withAttributes returns the union of the given attributes.
+func withAttributes(attributes ...map[string]tfsdk.Attribute) map[string]tfsdk.Attribute {
+	union := map[string]tfsdk.Attribute{}
+	for _, m := range attributes {
+		for k, v := range m {
+			union[k] = v
+		}
+	}
+	return union
+}
+
+func contains(list []string, s string) bool {
+	for _, elem := range list {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold is like contains but ignores case, as the string validators of
+// the schemas do.
+func containsFold(list []string, s string) bool {
+	for _, elem := range list {
+		if strings.EqualFold(elem, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	maxItemsValidatorErr      = "List must contain at most %d elements."
+	minItemsValidatorErr      = "List must contain at least %d elements."
+	jsonValidatorErr          = "Must be valid JSON."
+	rateValidatorErr          = "Must be of the form `<number>/<unit>` where unit is one of `second`, `minute`, `hour`, `day`."
+	tcpFlagsValidatorErr      = "Must be a comma separated list of `SYN`, `ACK`, `FIN`, `RST`, `URG`, `PSH` and `ALL`, each optionally prefixed with `!`."
+	weekdaysValidatorErr      = "Must be a comma separated list of `Mon`, `Tue`, `Wed`, `Thu`, `Fri`, `Sat` and `Sun`, optionally prefixed with `!`."
+	requiresValueValidatorErr = "May only be set when `%s` is one of `%s`."
+	layoutValidatorErr        = "Must be of the form `%s`."
+	endpointConflictErr       = "`%s` conflicts with `%s`."
+	endpointProtocolErr       = "Ports may only be matched when `protocol` is one of `%s`."
+)
+
+var (
+	// portProtocols are the protocols EdgeOS allows ports to be matched for.
+	portProtocols = []string{"tcp", "udp", "tcp_udp"}
+
+	rateRegexp     = regexp.MustCompile(`^[1-9][0-9]*/(second|minute|hour|day)$`)
+	tcpFlagsRegexp = regexp.MustCompile(`^!?(SYN|ACK|FIN|RST|URG|PSH|ALL)(,!?(SYN|ACK|FIN|RST|URG|PSH|ALL))*$`)
+	weekdaysRegexp = regexp.MustCompile(`^!?(Mon|Tue|Wed|Thu|Fri|Sat|Sun)(,(Mon|Tue|Wed|Thu|Fri|Sat|Sun))*$`)
+)
+
+type maxItemsValidator struct {
+	max int
+}
+
+// maxItems ensures that a list of primitives does not contain more than max
+// elements.
+func maxItems(max int) tfsdk.AttributeValidator {
+	return maxItemsValidator{
+		max: max,
+	}
+}
+
+func (v maxItemsValidator) Description(context.Context) string {
+	return fmt.Sprintf(maxItemsValidatorErr, v.max)
+}
+
+func (v maxItemsValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf(maxItemsValidatorErr, v.max)
+}
+
+func (v maxItemsValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var list types.List
+	{
+		diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &list)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if list.Unknown || list.Null {
+		return
+	}
+
+	if len(list.Elems) > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Too Many Elements",
+			fmt.Sprintf(maxItemsValidatorErr, v.max),
+		)
+	}
+}
+
+type minItemsValidator struct {
+	min int
+}
+
+// minItems ensures that a list of primitives contains at least min elements.
+func minItems(min int) tfsdk.AttributeValidator {
+	return minItemsValidator{
+		min: min,
+	}
+}
+
+func (v minItemsValidator) Description(context.Context) string {
+	return fmt.Sprintf(minItemsValidatorErr, v.min)
+}
+
+func (v minItemsValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf(minItemsValidatorErr, v.min)
+}
+
+func (v minItemsValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var list types.List
+	{
+		diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &list)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if list.Unknown || list.Null {
+		return
+	}
+
+	if len(list.Elems) < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Too Few Elements",
+			fmt.Sprintf(minItemsValidatorErr, v.min),
+		)
+	}
+}
+
+type jsonValidator struct{}
+
+// validJSON ensures that a string is a JSON document.
+func validJSON() tfsdk.AttributeValidator {
+	return jsonValidator{}
+}
+
+func (v jsonValidator) Description(context.Context) string {
+	return jsonValidatorErr
+}
+
+func (v jsonValidator) MarkdownDescription(context.Context) string {
+	return jsonValidatorErr
+}
+
+func (v jsonValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var s types.String
+	{
+		diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &s)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if s.Unknown || s.Null {
+		return
+	}
+
+	if !json.Valid([]byte(s.Value)) {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid JSON",
+			fmt.Sprintf("%s Got `%s`.", jsonValidatorErr, s.Value),
+		)
+	}
+}
+
+type matchesValidator struct {
+	re      *regexp.Regexp
+	summary string
+	err     string
+}
+
+// rate ensures that a string is a rate in the syntax EdgeOS expects, e.g.
+// `3/minute`.
+func rate() tfsdk.AttributeValidator {
+	return matchesValidator{
+		re:      rateRegexp,
+		summary: "Invalid Rate",
+		err:     rateValidatorErr,
+	}
+}
+
+// tcpFlags ensures that a string is a set of TCP flags in the syntax EdgeOS
+// expects, e.g. `SYN,!ACK`.
+func tcpFlags() tfsdk.AttributeValidator {
+	return matchesValidator{
+		re:      tcpFlagsRegexp,
+		summary: "Invalid TCP Flags",
+		err:     tcpFlagsValidatorErr,
+	}
+}
+
+// weekdays ensures that a string is a list of weekdays in the syntax EdgeOS
+// expects, e.g. `Mon,Tue` or `!Sat,Sun`.
+func weekdays() tfsdk.AttributeValidator {
+	return matchesValidator{
+		re:      weekdaysRegexp,
+		summary: "Invalid Weekdays",
+		err:     weekdaysValidatorErr,
+	}
+}
+
+func (v matchesValidator) Description(context.Context) string {
+	return v.err
+}
+
+func (v matchesValidator) MarkdownDescription(context.Context) string {
+	return v.err
+}
+
+func (v matchesValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var s types.String
+	{
+		diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &s)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if s.Unknown || s.Null {
+		return
+	}
+
+	if !v.re.MatchString(s.Value) {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			v.summary,
+			fmt.Sprintf("%s Got `%s`.", v.err, s.Value),
+		)
+	}
+}
+
+type layoutValidator struct {
+	layout  string
+	example string
+}
+
+// date ensures that a string is a date of the form `YYYY-MM-DD`.
+func date() tfsdk.AttributeValidator {
+	return layoutValidator{
+		layout:  "2006-01-02",
+		example: "YYYY-MM-DD",
+	}
+}
+
+// timeOfDay ensures that a string is a time of the form `hh:mm:ss`.
+func timeOfDay() tfsdk.AttributeValidator {
+	return layoutValidator{
+		layout:  "15:04:05",
+		example: "hh:mm:ss",
+	}
+}
+
+func (v layoutValidator) Description(context.Context) string {
+	return fmt.Sprintf(layoutValidatorErr, v.example)
+}
+
+func (v layoutValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v layoutValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var s types.String
+	{
+		diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &s)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if s.Unknown || s.Null {
+		return
+	}
+
+	if _, err := time.Parse(v.layout, s.Value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Format",
+			fmt.Sprintf("%s Got `%s`: %s", v.Description(ctx), s.Value, err.Error()),
+		)
+	}
+}
+
+type requiresValueValidator struct {
+	attribute string
+	values    []string
+}
+
+// requiresValue ensures that an attribute is only set when the string
+// attribute at the same level is set to one of the given values. Nothing is
+// enforced while that attribute is unknown.
+func requiresValue(attribute string, values ...string) tfsdk.AttributeValidator {
+	return requiresValueValidator{
+		attribute: attribute,
+		values:    values,
+	}
+}
+
+func (v requiresValueValidator) Description(context.Context) string {
+	return fmt.Sprintf(requiresValueValidatorErr, v.attribute, strings.Join(v.values, "`, `"))
+}
+
+func (v requiresValueValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v requiresValueValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	this, err := req.AttributeConfig.ToTerraformValue(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Attribute Combination",
+			"The validator had an internal error: "+err.Error(),
+		)
+		return
+	}
+
+	// We don't need to do any validation if the value isn't set.
+	if this == nil {
+		return
+	}
+
+	var s types.String
+	{
+		diags := req.Config.GetAttribute(ctx, req.AttributePath.WithoutLastStep().WithAttributeName(v.attribute), &s)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || s.Unknown {
+			return
+		}
+	}
+
+	if s.Null || !containsFold(v.values, s.Value) {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Attribute Combination",
+			v.Description(ctx),
+		)
+	}
+}
+
+type endpointValidator struct{}
+
+// endpoint ensures that the source or destination of a rule does not set both
+// an address and an address group, or both a port and a port group, and that
+// ports are only matched when the `protocol` at the same level as the source
+// or destination has ports. EdgeOS would otherwise only reject the rule on
+// commit.
+func endpoint() tfsdk.AttributeValidator {
+	return endpointValidator{}
+}
+
+func (v endpointValidator) Description(context.Context) string {
+	return fmt.Sprintf(endpointProtocolErr, strings.Join(portProtocols, "`, `"))
+}
+
+func (v endpointValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v endpointValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var endpoint types.Object
+	{
+		diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &endpoint)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || endpoint.Unknown || endpoint.Null {
+			return
+		}
+	}
+
+	// Unknown values are not considered set as they may still turn out null.
+	isSet := func(attribute string) bool {
+		value, ok := endpoint.Attrs[attribute]
+		if !ok {
+			return false
+		}
+		data, err := value.ToTerraformValue(ctx)
+		return err == nil && data != nil && data != tftypes.UnknownValue
+	}
+
+	for _, pair := range [][2]string{{"address", "address_group"}, {"port", "port_group"}} {
+		if isSet(pair[0]) && isSet(pair[1]) {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath.WithAttributeName(pair[1]),
+				"Conflicting Attributes",
+				fmt.Sprintf(endpointConflictErr, pair[1], pair[0]),
+			)
+		}
+	}
+
+	if !isSet("port") && !isSet("port_group") {
+		return
+	}
+
+	var protocol types.String
+	{
+		diags := req.Config.GetAttribute(ctx, req.AttributePath.WithoutLastStep().WithAttributeName("protocol"), &protocol)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || protocol.Unknown {
+			return
+		}
+	}
+
+	if !protocol.Null && containsFold(portProtocols, protocol.Value) {
+		return
+	}
+
+	detail := v.Description(ctx)
+	if !protocol.Null {
+		detail = fmt.Sprintf("%s Got `%s`.", detail, protocol.Value)
+	}
+	for _, attribute := range []string{"port", "port_group"} {
+		if isSet(attribute) {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath.WithAttributeName(attribute),
+				"Invalid Attribute Combination",
+				detail,
+			)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresValue(t *testing.T) {
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"protocol":  {Type: types.StringType, Optional: true},
+			"tcp_flags": {Type: types.StringType, Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"protocol":  tftypes.String,
+		"tcp_flags": tftypes.String,
+	}}
+
+	for _, test := range []struct {
+		name     string
+		protocol tftypes.Value
+		flags    tftypes.Value
+		invalid  bool
+	}{
+		{
+			name:     "matching protocol",
+			protocol: tftypes.NewValue(tftypes.String, "tcp"),
+			flags:    tftypes.NewValue(tftypes.String, "SYN"),
+		},
+		{
+			name:     "matching protocol in upper case",
+			protocol: tftypes.NewValue(tftypes.String, "TCP"),
+			flags:    tftypes.NewValue(tftypes.String, "SYN"),
+		},
+		{
+			name:     "other protocol",
+			protocol: tftypes.NewValue(tftypes.String, "udp"),
+			flags:    tftypes.NewValue(tftypes.String, "SYN"),
+			invalid:  true,
+		},
+		{
+			name:     "no protocol",
+			protocol: tftypes.NewValue(tftypes.String, nil),
+			flags:    tftypes.NewValue(tftypes.String, "SYN"),
+			invalid:  true,
+		},
+		{
+			name:     "unknown protocol",
+			protocol: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			flags:    tftypes.NewValue(tftypes.String, "SYN"),
+		},
+		{
+			name:     "not set",
+			protocol: tftypes.NewValue(tftypes.String, "udp"),
+			flags:    tftypes.NewValue(tftypes.String, nil),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			config := tfsdk.Config{
+				Schema: schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"protocol":  test.protocol,
+					"tcp_flags": test.flags,
+				}),
+			}
+
+			path := tftypes.NewAttributePath().WithAttributeName("tcp_flags")
+			var flags types.String
+			if diags := config.GetAttribute(ctx, path, &flags); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			var resp tfsdk.ValidateAttributeResponse
+			requiresValue("protocol", "tcp").Validate(ctx, tfsdk.ValidateAttributeRequest{
+				AttributePath:   path,
+				AttributeConfig: flags,
+				Config:          config,
+			}, &resp)
+
+			if resp.Diagnostics.HasError() != test.invalid {
+				t.Errorf("expected invalid to be %t, got %v", test.invalid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTCPFlags(t *testing.T) {
+	for flags, valid := range map[string]bool{
+		"SYN":          true,
+		"SYN,!ACK":     true,
+		"!FIN,RST,PSH": true,
+		"syn":          false,
+		"SYN,":         false,
+		"SYN ACK":      false,
+	} {
+		var resp tfsdk.ValidateAttributeResponse
+		tcpFlags().Validate(context.Background(), tfsdk.ValidateAttributeRequest{
+			AttributePath:   tftypes.NewAttributePath().WithAttributeName("tcp_flags"),
+			AttributeConfig: types.String{Value: flags},
+		}, &resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("expected %s to be valid: %t, got %v", flags, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestValidJSON(t *testing.T) {
+	for value, valid := range map[string]bool{
+		`"30"`:                       true,
+		`null`:                       true,
+		`{"transmit-interval":"30"}`: true,
+		`{"transmit-interval":"30"`:  false,
+		`{transmit-interval = "30"}`: false,
+	} {
+		var resp tfsdk.ValidateAttributeResponse
+		validJSON().Validate(context.Background(), tfsdk.ValidateAttributeRequest{
+			AttributePath:   tftypes.NewAttributePath().WithAttributeName("json"),
+			AttributeConfig: types.String{Value: value},
+		}, &resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("expected %s to be valid: %t, got %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestLayout(t *testing.T) {
+	for _, test := range []struct {
+		validator tfsdk.AttributeValidator
+		value     string
+		valid     bool
+	}{
+		{validator: date(), value: "2022-09-01", valid: true},
+		{validator: date(), value: "2022-13-01", valid: false},
+		{validator: date(), value: "2022-9-1", valid: false},
+		{validator: timeOfDay(), value: "21:30:00", valid: true},
+		{validator: timeOfDay(), value: "24:00:00", valid: false},
+		{validator: timeOfDay(), value: "21:30", valid: false},
+		{validator: weekdays(), value: "Mon,Tue", valid: true},
+		{validator: weekdays(), value: "!Sat,Sun", valid: true},
+		{validator: weekdays(), value: "Mon,!Tue", valid: false},
+		{validator: weekdays(), value: "Monday", valid: false},
+	} {
+		var resp tfsdk.ValidateAttributeResponse
+		test.validator.Validate(context.Background(), tfsdk.ValidateAttributeRequest{
+			AttributePath:   tftypes.NewAttributePath().WithAttributeName("time"),
+			AttributeConfig: types.String{Value: test.value},
+		}, &resp)
+
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("expected %s to be valid: %t, got %v", test.value, test.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	ctx := context.Background()
+	schema := tfsdk.Schema{Attributes: firewallMatchAttributes()}
+	objectType := schema.TerraformType(ctx).(tftypes.Object)
+	destinationType := objectType.AttributeTypes["destination"].(tftypes.Object)
+	portType := destinationType.AttributeTypes["port"].(tftypes.Object)
+
+	port := objectValue(portType, map[string]tftypes.Value{
+		"from": tftypes.NewValue(tftypes.Number, 22),
+		"to":   tftypes.NewValue(tftypes.Number, 22),
+	})
+	group := tftypes.NewValue(tftypes.String, "group")
+
+	for _, test := range []struct {
+		name        string
+		protocol    interface{}
+		destination map[string]tftypes.Value
+		invalid     []string
+	}{
+		{
+			name:        "port with tcp",
+			protocol:    "tcp",
+			destination: map[string]tftypes.Value{"port": port},
+		},
+		{
+			name:        "port with TCP",
+			protocol:    "TCP",
+			destination: map[string]tftypes.Value{"port": port},
+		},
+		{
+			name:        "port group with tcp_udp",
+			protocol:    "tcp_udp",
+			destination: map[string]tftypes.Value{"port_group": group},
+		},
+		{
+			name:        "port without protocol",
+			destination: map[string]tftypes.Value{"port": port},
+			invalid:     []string{"port"},
+		},
+		{
+			name:        "port group with negated protocol",
+			protocol:    "!tcp",
+			destination: map[string]tftypes.Value{"port_group": group},
+			invalid:     []string{"port_group"},
+		},
+		{
+			name:        "port with unknown protocol",
+			protocol:    tftypes.UnknownValue,
+			destination: map[string]tftypes.Value{"port": port},
+		},
+		{
+			name:     "port and port group",
+			protocol: "udp",
+			destination: map[string]tftypes.Value{
+				"port":       port,
+				"port_group": group,
+			},
+			invalid: []string{"port_group"},
+		},
+		{
+			name:     "address and address group",
+			protocol: "icmp",
+			destination: map[string]tftypes.Value{
+				"address":       tftypes.NewValue(tftypes.String, "192.168.1.0/24"),
+				"address_group": group,
+			},
+			invalid: []string{"address_group"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schema,
+				Raw: objectValue(objectType, map[string]tftypes.Value{
+					"protocol":    tftypes.NewValue(tftypes.String, test.protocol),
+					"destination": objectValue(destinationType, test.destination),
+				}),
+			}
+
+			path := tftypes.NewAttributePath().WithAttributeName("destination")
+			var destination types.Object
+			if diags := config.GetAttribute(ctx, path, &destination); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			var resp tfsdk.ValidateAttributeResponse
+			endpoint().Validate(ctx, tfsdk.ValidateAttributeRequest{
+				AttributePath:   path,
+				AttributeConfig: destination,
+				Config:          config,
+			}, &resp)
+
+			if len(resp.Diagnostics) != len(test.invalid) {
+				t.Fatalf("expected %d diagnostics, got %v", len(test.invalid), resp.Diagnostics)
+			}
+			for i, attribute := range test.invalid {
+				d, ok := resp.Diagnostics[i].(diag.DiagnosticWithPath)
+				if !ok || !d.Path().Equal(path.WithAttributeName(attribute)) {
+					t.Errorf("expected a diagnostic for %s, got %v", attribute, resp.Diagnostics[i])
+				}
+			}
+		})
+	}
+}
+
+// objectValue returns a value of the given type where every attribute that is
+// not given is null.
+func TestConfigNodeConflicts(t *testing.T) {
+	ctx := context.Background()
+	schema := schemaConfigNode()
+	config := tfsdk.Config{
+		Schema: schema,
+		Raw: objectValue(schema.TerraformType(ctx).(tftypes.Object), map[string]tftypes.Value{
+			"path":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "service")}),
+			"value": tftypes.NewValue(tftypes.String, "30"),
+			"json":  tftypes.NewValue(tftypes.String, `{"lldp":null}`),
+		}),
+	}
+
+	for name, value := range map[string]string{"value": "30", "json": `{"lldp":null}`} {
+		var resp tfsdk.ValidateAttributeResponse
+		for _, validator := range schema.Attributes[name].Validators {
+			validator.Validate(ctx, tfsdk.ValidateAttributeRequest{
+				AttributePath:   tftypes.NewAttributePath().WithAttributeName(name),
+				AttributeConfig: types.String{Value: value},
+				Config:          config,
+			}, &resp)
+		}
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected %s to conflict", name)
+		}
+	}
+}
+
+func objectValue(typ tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		attrs[name] = value
+	}
+	return tftypes.NewValue(typ, attrs)
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const (
+	enable  = "enable"
+	disable = "disable"
+)
+
+// Toggle is a boolean that EdgeOS represents as either `enable` or `disable`.
+type Toggle bool
+
+func (t Toggle) MarshalJSON() ([]byte, error) {
+	if t {
+		return json.Marshal(enable)
+	}
+	return json.Marshal(disable)
+}
+
+func (t *Toggle) UnmarshalJSON(data []byte) error {
+	var val string
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	*t = val == enable
+	return nil
+}
+
+// flag is a valueless node (e.g. `enable-default-log`) whose mere presence
+// means true. When unmarshalling, it must not be a pointer so that
+// UnmarshalJSON is called for the null value the router returns.
+type flag struct {
+	present bool
+}
+
+func (f *flag) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+func (f *flag) UnmarshalJSON([]byte) error {
+	f.present = true
+	return nil
+}
+
+func (f flag) value() *bool {
+	if !f.present {
+		return nil
+	}
+	return boolptr(true)
+}
+
+func toFlag(b *bool) *flag {
+	if b == nil || !*b {
+		return nil
+	}
+	return &flag{present: true}
+}
+
+// toChoice returns a node whose only child is the valueless node c, e.g.
+// `fragment { match-frag }`.
+func toChoice(c *string) map[string]*flag {
+	if c == nil {
+		return nil
+	}
+	return map[string]*flag{*c: {present: true}}
+}
+
+// fromChoice returns the name of the only child of a node created by toChoice.
+func fromChoice(m map[string]json.RawMessage) *string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	c := sortedKeys(keys)[0]
+	return &c
+}
+
+// sortedKeys returns the keys of a tag node, ordered numerically when every
+// key is a number.
+func sortedKeys(keys []string) []string {
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, errA := strconv.Atoi(keys[i])
+		b, errB := strconv.Atoi(keys[j])
+		if errA != nil || errB != nil {
+			return keys[i] < keys[j]
+		}
+		return a < b
+	})
+	return keys
+}
+
+// index returns the key of the i-th element of a list that EdgeOS stores as a
+// tag node keyed by 1, 2, ...
+func index(i int) string {
+	return strconv.Itoa(i + 1)
+}
+
+func malformed(what string, data []byte, err error) error {
+	return fmt.Errorf("Error setting %s from json `%s`: %s", what, string(data), err.Error())
+}
+
+func boolptr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ConfigNode is an arbitrary node of the configuration. A leaf has a Value,
+// any other node its subtree as JSON. A node with neither is valueless, such
+// as `service lldp interface all`.
+type ConfigNode struct {
+	ID    tftypes.String `json:"-" tfsdk:"id"`
+	Path  []string       `json:"-" tfsdk:"path"`
+	Value *string        `json:"-" tfsdk:"value"`
+	JSON  *string        `json:"-" tfsdk:"json"`
+}
+
+// GetID joins the path with spaces. If that would be ambiguous, because an
+// element is empty, contains whitespace or starts with `[`, the path is
+// encoded as a JSON array instead.
+func (n *ConfigNode) GetID() string {
+	for _, element := range n.Path {
+		if element == "" || strings.IndexFunc(element, unicode.IsSpace) >= 0 || strings.HasPrefix(element, "[") {
+			data, _ := json.Marshal(n.Path)
+			return string(data)
+		}
+	}
+	return strings.Join(n.Path, " ")
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+func (n ConfigNode) MarshalJSON() ([]byte, error) {
+	switch {
+	case n.Value != nil:
+		return json.Marshal(*n.Value)
+	case n.JSON != nil:
+		return []byte(*n.JSON), nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON keeps subtrees as compact JSON with sorted keys so that they
+// can be compared.
+func (n *ConfigNode) UnmarshalJSON(data []byte) error {
+	n.Value, n.JSON = nil, nil
+
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return malformed("config node", data, err)
+	}
+
+	switch t := tree.(type) {
+	case nil:
+		return nil
+	case string:
+		n.Value = &t
+		return nil
+	}
+
+	canonical, err := json.Marshal(tree)
+	if err != nil {
+		return malformed("config node", data, err)
+	}
+	s := string(canonical)
+	n.JSON = &s
+	return nil
+}
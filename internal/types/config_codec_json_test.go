@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestConfigNodeCodec(t *testing.T) {
+	value, subtree := "30", `{"interface":{"all":null},"transmit-interval":"30"}`
+	for _, test := range []struct {
+		node ConfigNode
+		tree string
+	}{
+		{node: ConfigNode{Value: &value}, tree: `"30"`},
+		{node: ConfigNode{JSON: &subtree}, tree: subtree},
+		{node: ConfigNode{}, tree: `null`},
+	} {
+		data, err := json.Marshal(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != test.tree {
+			t.Fatalf("expected %s, got %s", test.tree, string(data))
+		}
+
+		var actual ConfigNode
+		if err := json.Unmarshal(data, &actual); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(test.node, actual) {
+			t.Errorf("expected %+v, got %+v", test.node, actual)
+		}
+	}
+
+	var actual ConfigNode
+	if err := json.Unmarshal([]byte(`{ "transmit-interval": "30", "interface": { "all": null } }`), &actual); err != nil {
+		t.Fatal(err)
+	}
+	if actual.JSON == nil || *actual.JSON != subtree {
+		t.Errorf("expected the subtree to be compacted with sorted keys, got %v", actual.JSON)
+	}
+}
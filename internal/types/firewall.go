@@ -0,0 +1,332 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PortRange struct {
+	From int `tfsdk:"from"`
+	To   int `tfsdk:"to"`
+}
+
+type AddressGroup struct {
+	ID               tftypes.String `json:"-" tfsdk:"id"`
+	Name             string         `json:"-" tfsdk:"name"`
+	Description      *string        `json:"description,omitempty" tfsdk:"description"`
+	Cidrs            []string       `json:"address,omitempty" tfsdk:"cidrs"`
+	IgnoreUndeclared *bool          `json:"-" tfsdk:"ignore_undeclared_members"`
+}
+
+// AddressGroupMember is a single cidr of an address group that is managed on
+// its own.
+type AddressGroupMember struct {
+	ID    tftypes.String `json:"-" tfsdk:"id"`
+	Group string         `json:"-" tfsdk:"group"`
+	Cidr  string         `json:"-" tfsdk:"cidr"`
+}
+
+type PortGroup struct {
+	ID               tftypes.String `json:"-" tfsdk:"id"`
+	Name             string         `json:"-" tfsdk:"name"`
+	Description      *string        `json:"description,omitempty" tfsdk:"description"`
+	Ports            []int          `json:"-" tfsdk:"ports"`
+	Ranges           []*PortRange   `json:"-" tfsdk:"port_ranges"`
+	IgnoreUndeclared *bool          `json:"-" tfsdk:"ignore_undeclared_members"`
+}
+
+// PortGroupMember is a single port or port range of a port group that is
+// managed on its own. A single port has no To.
+type PortGroupMember struct {
+	ID    tftypes.String `json:"-" tfsdk:"id"`
+	Group string         `json:"-" tfsdk:"group"`
+	From  int            `json:"-" tfsdk:"from"`
+	To    *int           `json:"-" tfsdk:"to"`
+}
+
+type Source struct {
+	Address      *string    `json:"address,omitempty" tfsdk:"address"`
+	AddressGroup *string    `json:"-" tfsdk:"address_group"`
+	PortGroup    *string    `json:"-" tfsdk:"port_group"`
+	Port         *PortRange `json:"-" tfsdk:"port"`
+	MAC          *string    `json:"mac,omitempty" tfsdk:"mac"`
+}
+
+type Destination struct {
+	Address      *string    `json:"address,omitempty" tfsdk:"address"`
+	AddressGroup *string    `json:"-" tfsdk:"address_group"`
+	PortGroup    *string    `json:"-" tfsdk:"port_group"`
+	Port         *PortRange `json:"-" tfsdk:"port"`
+}
+
+type State struct {
+	Established *Toggle `json:"established,omitempty" tfsdk:"established"`
+	Invalid     *Toggle `json:"invalid,omitempty" tfsdk:"invalid"`
+	New         *Toggle `json:"new,omitempty" tfsdk:"new"`
+	Related     *Toggle `json:"related,omitempty" tfsdk:"related"`
+}
+
+// Limit restricts how often a rule matches, e.g. `3/minute`.
+type Limit struct {
+	Rate  string `json:"rate" tfsdk:"rate"`
+	Burst *int   `json:"burst,omitempty,string" tfsdk:"burst"`
+}
+
+// Recent matches hosts that were seen at least Count times within the last
+// Time seconds.
+type Recent struct {
+	Count int `json:"count,string" tfsdk:"count"`
+	Time  int `json:"time,string" tfsdk:"time"`
+}
+
+// ICMP matches ICMP traffic either by the name of its type, e.g.
+// `echo-request`, or by its numeric type and code.
+type ICMP struct {
+	TypeName *string `json:"type-name,omitempty" tfsdk:"type_name"`
+	Type     *int    `json:"type,omitempty,string" tfsdk:"type"`
+	Code     *int    `json:"code,omitempty,string" tfsdk:"code"`
+}
+
+// Time restricts when a rule matches. Dates are of the form `2006-01-02`,
+// times of the form `15:04:05` and weekdays a comma separated list such as
+// `Mon,Tue`.
+type Time struct {
+	StartDate *string `json:"startdate,omitempty" tfsdk:"start_date"`
+	StopDate  *string `json:"stopdate,omitempty" tfsdk:"stop_date"`
+	StartTime *string `json:"starttime,omitempty" tfsdk:"start_time"`
+	StopTime  *string `json:"stoptime,omitempty" tfsdk:"stop_time"`
+	Weekdays  *string `json:"weekdays,omitempty" tfsdk:"weekdays"`
+	UTC       *bool   `json:"-" tfsdk:"utc"`
+}
+
+type Rule struct {
+	Priority    int          `json:"-" tfsdk:"priority"`
+	Description *string      `json:"description,omitempty" tfsdk:"description"`
+	Log         *Toggle      `json:"log,omitempty" tfsdk:"log"`
+	Action      string       `json:"action" tfsdk:"action"`
+	Protocol    *string      `json:"protocol,omitempty" tfsdk:"protocol"`
+	State       *State       `json:"state,omitempty" tfsdk:"state"`
+	Source      *Source      `json:"source,omitempty" tfsdk:"source"`
+	Destination *Destination `json:"destination,omitempty" tfsdk:"destination"`
+	TCPFlags    *string      `json:"-" tfsdk:"tcp_flags"`
+	ICMP        *ICMP        `json:"icmp,omitempty" tfsdk:"icmp"`
+	Fragment    *string      `json:"-" tfsdk:"fragment"`
+	IPsec       *string      `json:"-" tfsdk:"ipsec"`
+	Limit       *Limit       `json:"limit,omitempty" tfsdk:"limit"`
+	Recent      *Recent      `json:"recent,omitempty" tfsdk:"recent"`
+	Time        *Time        `json:"time,omitempty" tfsdk:"time"`
+}
+
+// FirewallRule is a single rule of a ruleset that is managed on its own. It
+// has the same attributes as Rule, which it is converted to and from by
+// field name. They cannot be shared by embedding Rule as the framework
+// requires a tfsdk tag on every field.
+type FirewallRule struct {
+	ID          tftypes.String `json:"-" tfsdk:"id"`
+	Ruleset     string         `json:"-" tfsdk:"ruleset"`
+	Priority    int            `json:"-" tfsdk:"priority"`
+	Description *string        `json:"-" tfsdk:"description"`
+	Log         *Toggle        `json:"-" tfsdk:"log"`
+	Action      string         `json:"-" tfsdk:"action"`
+	Protocol    *string        `json:"-" tfsdk:"protocol"`
+	State       *State         `json:"-" tfsdk:"state"`
+	Source      *Source        `json:"-" tfsdk:"source"`
+	Destination *Destination   `json:"-" tfsdk:"destination"`
+	TCPFlags    *string        `json:"-" tfsdk:"tcp_flags"`
+	ICMP        *ICMP          `json:"-" tfsdk:"icmp"`
+	Fragment    *string        `json:"-" tfsdk:"fragment"`
+	IPsec       *string        `json:"-" tfsdk:"ipsec"`
+	Limit       *Limit         `json:"-" tfsdk:"limit"`
+	Recent      *Recent        `json:"-" tfsdk:"recent"`
+	Time        *Time          `json:"-" tfsdk:"time"`
+}
+
+// rule returns the rule of the ruleset r belongs to.
+func (r *FirewallRule) rule() *Rule {
+	var rule Rule
+	copyFields(&rule, r)
+	return &rule
+}
+
+// setRule sets the attributes r shares with rule.
+func (r *FirewallRule) setRule(rule *Rule) {
+	copyFields(r, rule)
+}
+
+// copyFields copies every field of the struct src points to into the field of
+// the same name and type of the struct dst points to.
+func copyFields(dst, src interface{}) {
+	d, s := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem()
+	for i := 0; i < d.NumField(); i++ {
+		field := d.Type().Field(i)
+		if v := s.FieldByName(field.Name); v.IsValid() && v.Type() == field.Type {
+			d.Field(i).Set(v)
+		}
+	}
+}
+
+type Ruleset struct {
+	ID               tftypes.String `json:"-" tfsdk:"id"`
+	Name             string         `json:"-" tfsdk:"name"`
+	Description      *string        `json:"description,omitempty" tfsdk:"description"`
+	DefaultAction    string         `json:"default-action" tfsdk:"default_action"`
+	DefaultLogging   *bool          `json:"-" tfsdk:"default_logging"`
+	Rules            []*Rule        `json:"-" tfsdk:"rule"` // Omitting the json tag due to custom marshal/unmarshal methods.
+	ForceDetach      *bool          `json:"-" tfsdk:"force_detach"`
+	IgnoreUndeclared *bool          `json:"-" tfsdk:"ignore_undeclared_rules"`
+}
+
+type ConnMark struct {
+	SetMark     *int  `json:"set-mark,omitempty,string" tfsdk:"set_mark"`
+	SaveMark    *bool `json:"-" tfsdk:"save_mark"`
+	RestoreMark *bool `json:"-" tfsdk:"restore_mark"`
+}
+
+// Modify describes how a packet matched by a modify rule is altered.
+type Modify struct {
+	Table    *string   `json:"table,omitempty" tfsdk:"table"`
+	Mark     *int      `json:"mark,omitempty,string" tfsdk:"mark"`
+	DSCP     *int      `json:"dscp,omitempty,string" tfsdk:"dscp"`
+	ConnMark *ConnMark `json:"connmark,omitempty" tfsdk:"connmark"`
+	LBGroup  *string   `json:"lb-group,omitempty" tfsdk:"lb_group"`
+}
+
+type ModifyRule struct {
+	Priority    int          `json:"-" tfsdk:"priority"`
+	Description *string      `json:"description,omitempty" tfsdk:"description"`
+	Log         *Toggle      `json:"log,omitempty" tfsdk:"log"`
+	Action      string       `json:"action" tfsdk:"action"`
+	Protocol    *string      `json:"protocol,omitempty" tfsdk:"protocol"`
+	State       *State       `json:"state,omitempty" tfsdk:"state"`
+	Source      *Source      `json:"source,omitempty" tfsdk:"source"`
+	Destination *Destination `json:"destination,omitempty" tfsdk:"destination"`
+	Modify      *Modify      `json:"modify,omitempty" tfsdk:"modify"`
+}
+
+type ModifyRuleset struct {
+	ID          tftypes.String `json:"-" tfsdk:"id"`
+	Name        string         `json:"-" tfsdk:"name"`
+	Description *string        `json:"description,omitempty" tfsdk:"description"`
+	Rules       []*ModifyRule  `json:"-" tfsdk:"rule"` // Omitting the json tag due to custom marshal/unmarshal methods.
+}
+
+type ModifyAttachment struct {
+	In  *string `tfsdk:"in"`
+	Out *string `tfsdk:"out"`
+}
+
+type FirewallAttachment struct {
+	ID        tftypes.String    `json:"-" tfsdk:"id"`
+	Interface string            `json:"-" tfsdk:"interface"`
+	In        *string           `json:"-" tfsdk:"in"`
+	Out       *string           `json:"-" tfsdk:"out"`
+	Local     *string           `json:"-" tfsdk:"local"`
+	Modify    *ModifyAttachment `json:"-" tfsdk:"modify"`
+}
+
+// FirewallGlobalOptionsID is the identifier of the global firewall options.
+// There is only one set per router.
+const FirewallGlobalOptionsID = "firewall"
+
+type MSSClamp struct {
+	InterfaceTypes []string `json:"interface-type,omitempty" tfsdk:"interface_types"`
+	MSS            *int     `json:"mss,omitempty,string" tfsdk:"mss"`
+}
+
+type FirewallGlobalOptions struct {
+	ID                   tftypes.String `json:"-" tfsdk:"id"`
+	AllPing              *Toggle        `json:"all-ping,omitempty" tfsdk:"all_ping"`
+	BroadcastPing        *Toggle        `json:"broadcast-ping,omitempty" tfsdk:"broadcast_ping"`
+	IPSourceRoute        *Toggle        `json:"ip-src-route,omitempty" tfsdk:"ip_src_route"`
+	LogMartians          *Toggle        `json:"log-martians,omitempty" tfsdk:"log_martians"`
+	ReceiveRedirects     *Toggle        `json:"receive-redirects,omitempty" tfsdk:"receive_redirects"`
+	SendRedirects        *Toggle        `json:"send-redirects,omitempty" tfsdk:"send_redirects"`
+	SourceValidation     *string        `json:"source-validation,omitempty" tfsdk:"source_validation"`
+	SynCookies           *Toggle        `json:"syn-cookies,omitempty" tfsdk:"syn_cookies"`
+	TWAHazardsProtection *Toggle        `json:"twa-hazards-protection,omitempty" tfsdk:"twa_hazards_protection"`
+	IPv6ReceiveRedirects *Toggle        `json:"ipv6-receive-redirects,omitempty" tfsdk:"ipv6_receive_redirects"`
+	IPv6SourceRoute      *Toggle        `json:"ipv6-src-route,omitempty" tfsdk:"ipv6_src_route"`
+	MSSClamp             *MSSClamp      `json:"-" tfsdk:"mss_clamp"`
+}
+
+func (g *AddressGroup) GetID() string {
+	return g.Name
+}
+
+func (m *AddressGroupMember) GetID() string {
+	return m.Group + "/" + m.Cidr
+}
+
+func (g *PortGroup) GetID() string {
+	return g.Name
+}
+
+func (m *PortGroupMember) GetID() string {
+	return m.Group + "/" + m.Port()
+}
+
+// Port returns the member as EdgeOS stores it, e.g. `22` or `8000-8100`.
+func (m *PortGroupMember) Port() string {
+	if m.To == nil {
+		return strconv.Itoa(m.From)
+	}
+	return (&PortRange{From: m.From, To: *m.To}).toPort()
+}
+
+func (rs *Ruleset) GetID() string {
+	return rs.Name
+}
+
+func (r *FirewallRule) GetID() string {
+	return r.Ruleset + "/" + strconv.Itoa(r.Priority)
+}
+
+func (rs *ModifyRuleset) GetID() string {
+	return rs.Name
+}
+
+func (a *FirewallAttachment) GetID() string {
+	return a.Interface
+}
+
+func (o *FirewallGlobalOptions) GetID() string {
+	return FirewallGlobalOptionsID
+}
+
+func (p *PortRange) toPort() string {
+	if p == nil {
+		return ""
+	}
+
+	if p.From == p.To {
+		return strconv.Itoa(p.From)
+	}
+	return fmt.Sprintf("%d-%d", p.From, p.To)
+}
+
+func fromPort(port string) (*PortRange, error) {
+	if port == "" {
+		return nil, nil
+	}
+
+	fromTo := strings.SplitN(port, "-", 2)
+
+	from, err := strconv.Atoi(fromTo[0])
+	if err != nil {
+		return nil, fmt.Errorf("Could not turn %s into a valid port: %s", port, err.Error())
+	}
+
+	if len(fromTo) == 1 {
+		return &PortRange{From: from, To: from}, nil
+	}
+
+	to, err := strconv.Atoi(fromTo[1])
+	if err != nil {
+		return nil, fmt.Errorf("The \"to\" port is malformed for port range %s: %s", port, err.Error())
+	}
+	return &PortRange{From: from, To: to}, nil
+}
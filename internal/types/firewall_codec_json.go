@@ -0,0 +1,472 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type apiGroup struct {
+	Address *string `json:"address-group,omitempty"`
+	Port    *string `json:"port-group,omitempty"`
+}
+
+func toGroup(address, port *string) *apiGroup {
+	if address == nil && port == nil {
+		return nil
+	}
+	return &apiGroup{
+		Address: address,
+		Port:    port,
+	}
+}
+
+func (g PortGroup) MarshalJSON() ([]byte, error) {
+	ports := []string{}
+	for _, port := range g.Ports {
+		ports = append(ports, strconv.Itoa(port))
+	}
+	for _, r := range g.Ranges {
+		ports = append(ports, r.toPort())
+	}
+
+	type Alias PortGroup
+	return json.Marshal(&struct {
+		Ports []string `json:"port,omitempty"`
+		*Alias
+	}{
+		Ports: ports,
+		Alias: (*Alias)(&g),
+	})
+}
+
+func (g *PortGroup) UnmarshalJSON(data []byte) error {
+	type Alias PortGroup
+	aux := &struct {
+		Ports []string `json:"port"`
+		*Alias
+	}{
+		Alias: (*Alias)(g),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("port group", data, err)
+	}
+
+	g.Ports, g.Ranges = nil, nil
+	for _, port := range aux.Ports {
+		r, err := fromPort(port)
+		if err != nil {
+			return malformed("port group", data, err)
+		}
+		if !strings.Contains(port, "-") {
+			g.Ports = append(g.Ports, r.From)
+			continue
+		}
+		g.Ranges = append(g.Ranges, r)
+	}
+	return nil
+}
+
+func (s *Source) MarshalJSON() ([]byte, error) {
+	type Alias Source
+	return json.Marshal(&struct {
+		Port  string    `json:"port,omitempty"`
+		Group *apiGroup `json:"group,omitempty"`
+		*Alias
+	}{
+		Port:  s.Port.toPort(),
+		Group: toGroup(s.AddressGroup, s.PortGroup),
+		Alias: (*Alias)(s),
+	})
+}
+
+func (s *Source) UnmarshalJSON(data []byte) error {
+	type Alias Source
+	aux := &struct {
+		Port  string   `json:"port"`
+		Group apiGroup `json:"group"`
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("source", data, err)
+	}
+
+	port, err := fromPort(aux.Port)
+	if err != nil {
+		return malformed("source", data, err)
+	}
+
+	s.Port = port
+	s.AddressGroup = aux.Group.Address
+	s.PortGroup = aux.Group.Port
+	return nil
+}
+
+func (d *Destination) MarshalJSON() ([]byte, error) {
+	type Alias Destination
+	return json.Marshal(&struct {
+		Port  string    `json:"port,omitempty"`
+		Group *apiGroup `json:"group,omitempty"`
+		*Alias
+	}{
+		Port:  d.Port.toPort(),
+		Group: toGroup(d.AddressGroup, d.PortGroup),
+		Alias: (*Alias)(d),
+	})
+}
+
+func (d *Destination) UnmarshalJSON(data []byte) error {
+	type Alias Destination
+	aux := &struct {
+		Port  string   `json:"port"`
+		Group apiGroup `json:"group"`
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("destination", data, err)
+	}
+
+	port, err := fromPort(aux.Port)
+	if err != nil {
+		return malformed("destination", data, err)
+	}
+
+	d.Port = port
+	d.AddressGroup = aux.Group.Address
+	d.PortGroup = aux.Group.Port
+	return nil
+}
+
+func (c *ConnMark) MarshalJSON() ([]byte, error) {
+	type Alias ConnMark
+	return json.Marshal(&struct {
+		SaveMark    *flag `json:"save-mark,omitempty"`
+		RestoreMark *flag `json:"restore-mark,omitempty"`
+		*Alias
+	}{
+		SaveMark:    toFlag(c.SaveMark),
+		RestoreMark: toFlag(c.RestoreMark),
+		Alias:       (*Alias)(c),
+	})
+}
+
+func (c *ConnMark) UnmarshalJSON(data []byte) error {
+	type Alias ConnMark
+	aux := &struct {
+		SaveMark    flag `json:"save-mark"`
+		RestoreMark flag `json:"restore-mark"`
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("connmark", data, err)
+	}
+
+	c.SaveMark = aux.SaveMark.value()
+	c.RestoreMark = aux.RestoreMark.value()
+	return nil
+}
+
+// anyProtocol matches every protocol. EdgeOS has no such value; a rule
+// without a protocol matches every protocol instead.
+const anyProtocol = "*"
+
+type apiTCP struct {
+	Flags *string `json:"flags,omitempty"`
+}
+
+func (r *Rule) MarshalJSON() ([]byte, error) {
+	protocol := r.Protocol
+	if protocol != nil && *protocol == anyProtocol {
+		protocol = nil
+	}
+
+	var tcp *apiTCP
+	if r.TCPFlags != nil {
+		tcp = &apiTCP{Flags: r.TCPFlags}
+	}
+
+	type Alias Rule
+	return json.Marshal(&struct {
+		Protocol *string          `json:"protocol,omitempty"`
+		TCP      *apiTCP          `json:"tcp,omitempty"`
+		Fragment map[string]*flag `json:"fragment,omitempty"`
+		IPsec    map[string]*flag `json:"ipsec,omitempty"`
+		*Alias
+	}{
+		Protocol: protocol,
+		TCP:      tcp,
+		Fragment: toChoice(r.Fragment),
+		IPsec:    toChoice(r.IPsec),
+		Alias:    (*Alias)(r),
+	})
+}
+
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	type Alias Rule
+	aux := &struct {
+		TCP      apiTCP                     `json:"tcp"`
+		Fragment map[string]json.RawMessage `json:"fragment"`
+		IPsec    map[string]json.RawMessage `json:"ipsec"`
+		*Alias
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("rule", data, err)
+	}
+
+	r.TCPFlags = aux.TCP.Flags
+	r.Fragment = fromChoice(aux.Fragment)
+	r.IPsec = fromChoice(aux.IPsec)
+	return nil
+}
+
+func (r *ModifyRule) MarshalJSON() ([]byte, error) {
+	protocol := r.Protocol
+	if protocol != nil && *protocol == anyProtocol {
+		protocol = nil
+	}
+
+	type Alias ModifyRule
+	return json.Marshal(&struct {
+		Protocol *string `json:"protocol,omitempty"`
+		*Alias
+	}{
+		Protocol: protocol,
+		Alias:    (*Alias)(r),
+	})
+}
+
+func (r FirewallRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.rule())
+}
+
+func (r *FirewallRule) UnmarshalJSON(data []byte) error {
+	rule := Rule{Priority: r.Priority}
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return err
+	}
+	r.setRule(&rule)
+	return nil
+}
+
+func (t *Time) MarshalJSON() ([]byte, error) {
+	type Alias Time
+	return json.Marshal(&struct {
+		UTC *flag `json:"utc,omitempty"`
+		*Alias
+	}{
+		UTC:   toFlag(t.UTC),
+		Alias: (*Alias)(t),
+	})
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	type Alias Time
+	aux := &struct {
+		UTC flag `json:"utc"`
+		*Alias
+	}{
+		Alias: (*Alias)(t),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("time", data, err)
+	}
+
+	t.UTC = aux.UTC.value()
+	return nil
+}
+
+func (rs Ruleset) MarshalJSON() ([]byte, error) {
+	rules := map[string]*Rule{}
+	for _, rule := range rs.Rules {
+		rules[strconv.Itoa(rule.Priority)] = rule
+	}
+
+	type Alias Ruleset
+	return json.Marshal(&struct {
+		DefaultLogging *flag            `json:"enable-default-log,omitempty"`
+		Rules          map[string]*Rule `json:"rule,omitempty"`
+		*Alias
+	}{
+		DefaultLogging: toFlag(rs.DefaultLogging),
+		Rules:          rules,
+		Alias:          (*Alias)(&rs),
+	})
+}
+
+func (rs *Ruleset) UnmarshalJSON(data []byte) error {
+	type Alias Ruleset
+	aux := &struct {
+		DefaultLogging flag             `json:"enable-default-log"`
+		Rules          map[string]*Rule `json:"rule"`
+		*Alias
+	}{
+		Alias: (*Alias)(rs),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("ruleset", data, err)
+	}
+
+	rs.DefaultLogging = aux.DefaultLogging.value()
+	rs.Rules = nil
+	keys := []string{}
+	for k := range aux.Rules {
+		keys = append(keys, k)
+	}
+	for _, k := range sortedKeys(keys) {
+		priority, err := strconv.Atoi(k)
+		if err != nil {
+			return malformed("ruleset", data, fmt.Errorf("malformed rule priority: %v", k))
+		}
+		rule := aux.Rules[k]
+		rule.Priority = priority
+		rs.Rules = append(rs.Rules, rule)
+	}
+	return nil
+}
+
+func (rs ModifyRuleset) MarshalJSON() ([]byte, error) {
+	rules := map[string]*ModifyRule{}
+	for _, rule := range rs.Rules {
+		rules[strconv.Itoa(rule.Priority)] = rule
+	}
+
+	type Alias ModifyRuleset
+	return json.Marshal(&struct {
+		Rules map[string]*ModifyRule `json:"rule,omitempty"`
+		*Alias
+	}{
+		Rules: rules,
+		Alias: (*Alias)(&rs),
+	})
+}
+
+func (rs *ModifyRuleset) UnmarshalJSON(data []byte) error {
+	type Alias ModifyRuleset
+	aux := &struct {
+		Rules map[string]*ModifyRule `json:"rule"`
+		*Alias
+	}{
+		Alias: (*Alias)(rs),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("modify ruleset", data, err)
+	}
+
+	rs.Rules = nil
+	keys := []string{}
+	for k := range aux.Rules {
+		keys = append(keys, k)
+	}
+	for _, k := range sortedKeys(keys) {
+		priority, err := strconv.Atoi(k)
+		if err != nil {
+			return malformed("modify ruleset", data, fmt.Errorf("malformed rule priority: %v", k))
+		}
+		rule := aux.Rules[k]
+		rule.Priority = priority
+		rs.Rules = append(rs.Rules, rule)
+	}
+	return nil
+}
+
+type apiAttachmentDetails struct {
+	Name   *string `json:"name,omitempty"`
+	Modify *string `json:"modify,omitempty"`
+}
+
+type apiAttachment struct {
+	In    *apiAttachmentDetails `json:"in,omitempty"`
+	Out   *apiAttachmentDetails `json:"out,omitempty"`
+	Local *apiAttachmentDetails `json:"local,omitempty"`
+}
+
+func (a FirewallAttachment) MarshalJSON() ([]byte, error) {
+	details := func(name, modify *string) *apiAttachmentDetails {
+		if name == nil && modify == nil {
+			return nil
+		}
+		return &apiAttachmentDetails{
+			Name:   name,
+			Modify: modify,
+		}
+	}
+
+	var in, out *string
+	if a.Modify != nil {
+		in, out = a.Modify.In, a.Modify.Out
+	}
+
+	return json.Marshal(&apiAttachment{
+		In:    details(a.In, in),
+		Out:   details(a.Out, out),
+		Local: details(a.Local, nil),
+	})
+}
+
+func (a *FirewallAttachment) UnmarshalJSON(data []byte) error {
+	var aux apiAttachment
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("firewall attachment", data, err)
+	}
+
+	a.In, a.Out, a.Local, a.Modify = nil, nil, nil, nil
+	modify := &ModifyAttachment{}
+	if aux.In != nil {
+		a.In, modify.In = aux.In.Name, aux.In.Modify
+	}
+	if aux.Out != nil {
+		a.Out, modify.Out = aux.Out.Name, aux.Out.Modify
+	}
+	if aux.Local != nil {
+		a.Local = aux.Local.Name
+	}
+	if modify.In != nil || modify.Out != nil {
+		a.Modify = modify
+	}
+	return nil
+}
+
+type apiFirewallOptions struct {
+	MSSClamp *MSSClamp `json:"mss-clamp,omitempty"`
+}
+
+func (o FirewallGlobalOptions) MarshalJSON() ([]byte, error) {
+	var options *apiFirewallOptions
+	if o.MSSClamp != nil {
+		options = &apiFirewallOptions{MSSClamp: o.MSSClamp}
+	}
+
+	type Alias FirewallGlobalOptions
+	return json.Marshal(&struct {
+		Options *apiFirewallOptions `json:"options,omitempty"`
+		*Alias
+	}{
+		Options: options,
+		Alias:   (*Alias)(&o),
+	})
+}
+
+func (o *FirewallGlobalOptions) UnmarshalJSON(data []byte) error {
+	type Alias FirewallGlobalOptions
+	aux := &struct {
+		Options apiFirewallOptions `json:"options"`
+		*Alias
+	}{
+		Alias: (*Alias)(o),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("firewall global options", data, err)
+	}
+
+	o.MSSClamp = aux.Options.MSSClamp
+	return nil
+}
@@ -0,0 +1,276 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPortGroupCodec(t *testing.T) {
+	expected := PortGroup{
+		Ports:  []int{22, 443},
+		Ranges: []*PortRange{{From: 8000, To: 8100}},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"port":["22","443","8000-8100"]}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual PortGroup
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestModifyRulesetCodec(t *testing.T) {
+	table := "10"
+	expected := ModifyRuleset{
+		Rules: []*ModifyRule{
+			{
+				Priority: 10,
+				Action:   "modify",
+				Source: &Source{
+					AddressGroup: &table,
+					Port:         &PortRange{From: 80, To: 90},
+				},
+				Modify: &Modify{
+					Table: &table,
+					ConnMark: &ConnMark{
+						SaveMark: boolptr(true),
+					},
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"rule":{"10":{"action":"modify","source":{"port":"80-90","group":{"address-group":"10"}},"modify":{"table":"10","connmark":{"save-mark":null}}}}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual ModifyRuleset
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestModifyRuleAnyProtocol(t *testing.T) {
+	protocol, table := anyProtocol, "10"
+	data, err := json.Marshal(ModifyRuleset{
+		Rules: []*ModifyRule{
+			{
+				Priority: 10,
+				Action:   "modify",
+				Protocol: &protocol,
+				Modify:   &Modify{Table: &table},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"rule":{"10":{"action":"modify","modify":{"table":"10"}}}}`
+	if string(data) != tree {
+		t.Errorf("expected %s, got %s", tree, string(data))
+	}
+	if protocol != anyProtocol {
+		t.Errorf("expected the rule to be left untouched, got %s", protocol)
+	}
+}
+
+func TestRulesetCodec(t *testing.T) {
+	protocol, flags, burst, enabled := "tcp", "SYN,!ACK", 5, Toggle(true)
+	icmp, echo, fragment, ipsec := "icmp", "echo-request", "match-frag", "match-ipsec"
+	start, stop, weekdays := "21:00:00", "07:00:00", "Mon,Tue,Wed,Thu,Fri"
+	expected := Ruleset{
+		DefaultAction:  "drop",
+		DefaultLogging: boolptr(true),
+		Rules: []*Rule{
+			{
+				Priority: 10,
+				Action:   "accept",
+				Protocol: &protocol,
+				State: &State{
+					New: &enabled,
+				},
+				TCPFlags: &flags,
+				Limit: &Limit{
+					Rate:  "3/minute",
+					Burst: &burst,
+				},
+			},
+			{
+				Priority: 20,
+				Action:   "drop",
+				Protocol: &icmp,
+				ICMP: &ICMP{
+					TypeName: &echo,
+				},
+				Fragment: &fragment,
+				IPsec:    &ipsec,
+				Recent: &Recent{
+					Count: 4,
+					Time:  60,
+				},
+				Time: &Time{
+					StartTime: &start,
+					StopTime:  &stop,
+					Weekdays:  &weekdays,
+					UTC:       boolptr(true),
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"enable-default-log":null,"rule":{"10":{"protocol":"tcp","tcp":{"flags":"SYN,!ACK"},"action":"accept","state":{"new":"enable"},"limit":{"rate":"3/minute","burst":"5"}},"20":{"protocol":"icmp","fragment":{"match-frag":null},"ipsec":{"match-ipsec":null},"action":"drop","icmp":{"type-name":"echo-request"},"recent":{"count":"4","time":"60"},"time":{"utc":null,"starttime":"21:00:00","stoptime":"07:00:00","weekdays":"Mon,Tue,Wed,Thu,Fri"}}},"default-action":"drop"}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual Ruleset
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestFirewallRuleCodec(t *testing.T) {
+	protocol, fragment := "tcp", "match-frag"
+	expected := FirewallRule{
+		Action:   "accept",
+		Protocol: &protocol,
+		Destination: &Destination{
+			Port: &PortRange{From: 22, To: 22},
+		},
+		Fragment: &fragment,
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"protocol":"tcp","fragment":{"match-frag":null},"action":"accept","destination":{"port":"22"}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual FirewallRule
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestFirewallRuleFields(t *testing.T) {
+	rule, firewallRule := reflect.TypeOf(Rule{}), reflect.TypeOf(FirewallRule{})
+	for i := 0; i < rule.NumField(); i++ {
+		field := rule.Field(i)
+		actual, ok := firewallRule.FieldByName(field.Name)
+		if !ok || actual.Type != field.Type || actual.Tag.Get("tfsdk") != field.Tag.Get("tfsdk") {
+			t.Errorf("expected FirewallRule to have the field %s %s `tfsdk:\"%s\"`", field.Name, field.Type, field.Tag.Get("tfsdk"))
+		}
+	}
+
+	description, priority := "ssh", 10
+	expected := FirewallRule{Ruleset: "WAN_IN", Priority: priority, Action: "accept", Description: &description}
+	var actual FirewallRule
+	actual.Ruleset = "WAN_IN"
+	actual.setRule(expected.rule())
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestFirewallAttachmentCodec(t *testing.T) {
+	in, modify := "WAN_IN", "PBR"
+	expected := FirewallAttachment{
+		In: &in,
+		Modify: &ModifyAttachment{
+			In: &modify,
+		},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"in":{"name":"WAN_IN","modify":"PBR"}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual FirewallAttachment
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestFirewallGlobalOptionsCodec(t *testing.T) {
+	enabled, disabled, mss := Toggle(true), Toggle(false), 1412
+	expected := FirewallGlobalOptions{
+		AllPing:       &enabled,
+		BroadcastPing: &disabled,
+		MSSClamp: &MSSClamp{
+			InterfaceTypes: []string{"pppoe"},
+			MSS:            &mss,
+		},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"options":{"mss-clamp":{"interface-type":["pppoe"],"mss":"1412"}},"all-ping":"enable","broadcast-ping":"disable"}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	// The rulesets and groups are managed by other resources and must be ignored.
+	const actualTree = `{"all-ping":"enable","broadcast-ping":"disable","name":{"WAN_IN":{"default-action":"drop"}},"options":{"mss-clamp":{"interface-type":["pppoe"],"mss":"1412"}}}`
+	var actual FirewallGlobalOptions
+	if err := json.Unmarshal([]byte(actualTree), &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
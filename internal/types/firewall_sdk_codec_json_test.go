@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	sdk "github.com/frankgreco/edge-sdk-go/types"
+)
+
+// The firewall resources used to be (un)marshalled by edge-sdk-go. These tests
+// check that the configuration written by edge-sdk-go still reads the same and
+// that what we write reads the same with edge-sdk-go.
+
+// sdkRoundTrip marshals in with edge-sdk-go, unmarshals and marshals the result
+// with our codec into via and returns both trees.
+func sdkRoundTrip(t *testing.T, in, via interface{}) (map[string]interface{}, map[string]interface{}) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, via); err != nil {
+		t.Fatal(err)
+	}
+	roundTripped, err := json.Marshal(via)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sdkTree, tree map[string]interface{}
+	if err := json.Unmarshal(data, &sdkTree); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(roundTripped, &tree); err != nil {
+		t.Fatal(err)
+	}
+	return sdkTree, tree
+}
+
+func TestRulesetCodecMatchesSDK(t *testing.T) {
+	description, group, address := "allow ssh", "ADMINS", "192.168.1.0/24"
+	on, off := true, false
+	fixture := func() sdk.Ruleset {
+		return sdk.Ruleset{
+			Description:    &description,
+			DefaultAction:  "drop",
+			DefaultLogging: &on,
+			Rules: []*sdk.Rule{
+				{
+					Priority:    10,
+					Description: &description,
+					Action:      "accept",
+					Protocol:    "tcp",
+					Log:         &on,
+					Source: &sdk.Source{
+						AddressGroup: &group,
+					},
+					Destination: &sdk.Destination{
+						Address: &address,
+						Port:    &sdk.PortRange{From: 22, To: 22},
+					},
+					State: &sdk.State{
+						New:         &on,
+						Established: &on,
+					},
+				},
+				{
+					Priority: 20,
+					Action:   "drop",
+					Protocol: "*",
+					Log:      &off,
+				},
+			},
+		}
+	}
+
+	var ruleset Ruleset
+	sdkTree, tree := sdkRoundTrip(t, fixture(), &ruleset)
+
+	if _, ok := tree["enable-default-log"]; !ok || tree["enable-default-log"] != nil {
+		t.Errorf("expected enable-default-log to be a valueless node, got %v", tree)
+	}
+	rules := tree["rule"].(map[string]interface{})
+	if log := rules["10"].(map[string]interface{})["log"]; log != "enable" {
+		t.Errorf("expected log to be enabled, got %v", log)
+	}
+	if log := rules["20"].(map[string]interface{})["log"]; log != "disable" {
+		t.Errorf("expected log to be disabled, got %v", log)
+	}
+	for name, tree := range map[string]map[string]interface{}{"edge-sdk-go": sdkTree, "ours": tree} {
+		rule := tree["rule"].(map[string]interface{})["20"].(map[string]interface{})
+		if protocol, ok := rule["protocol"]; ok && protocol != "" {
+			t.Errorf("expected %s to drop the `*` protocol, got %v", name, protocol)
+		}
+	}
+
+	data, err := json.Marshal(ruleset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual sdk.Ruleset
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(actual.Rules, func(i, j int) bool {
+		return actual.Rules[i].Priority < actual.Rules[j].Priority
+	})
+
+	if expected := fixture(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestAddressGroupCodecMatchesSDK(t *testing.T) {
+	description := "admins"
+	expected := sdk.AddressGroup{
+		Description: &description,
+		Cidrs:       []string{"192.168.1.10", "10.0.0.0/24"},
+	}
+
+	var group AddressGroup
+	sdkTree, tree := sdkRoundTrip(t, expected, &group)
+	if !reflect.DeepEqual(sdkTree, tree) {
+		t.Errorf("expected %v, got %v", sdkTree, tree)
+	}
+}
+
+func TestPortGroupCodecMatchesSDK(t *testing.T) {
+	description := "web"
+	expected := sdk.PortGroup{
+		Description: &description,
+		Ports:       []int{80, 443},
+		Ranges:      []*sdk.PortRange{{From: 8000, To: 8100}},
+	}
+
+	var group PortGroup
+	sdkTree, tree := sdkRoundTrip(t, expected, &group)
+	if !reflect.DeepEqual(sdkTree, tree) {
+		t.Errorf("expected %v, got %v", sdkTree, tree)
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BridgeGroup is the membership of an interface in a bridge. It is configured
+// on the member rather than on the bridge.
+type BridgeGroup struct {
+	Bridge string `json:"bridge"`
+}
+
+type Bridge struct {
+	ID              tftypes.String `json:"-" tfsdk:"id"`
+	Name            string         `json:"-" tfsdk:"name"`
+	Description     *string        `json:"description,omitempty" tfsdk:"description"`
+	Addresses       []string       `json:"address,omitempty" tfsdk:"addresses"`
+	Members         []string       `json:"-" tfsdk:"members"`
+	STP             *bool          `json:"-" tfsdk:"stp"`
+	Priority        *int           `json:"priority,omitempty,string" tfsdk:"priority"`
+	Aging           *int           `json:"aging,omitempty,string" tfsdk:"aging"`
+	HelloTime       *int           `json:"hello-time,omitempty,string" tfsdk:"hello_time"`
+	ForwardingDelay *int           `json:"forwarding-delay,omitempty,string" tfsdk:"forwarding_delay"`
+	MaxAge          *int           `json:"max-age,omitempty,string" tfsdk:"max_age"`
+}
+
+func (b *Bridge) GetID() string {
+	return b.Name
+}
+
+type SwitchPort struct {
+	PVID *int  `json:"-" tfsdk:"pvid"`
+	VIDs []int `json:"-" tfsdk:"vids"`
+}
+
+type SwitchVIF struct {
+	Description *string  `json:"description,omitempty" tfsdk:"description"`
+	Addresses   []string `json:"address,omitempty" tfsdk:"addresses"`
+}
+
+type Switch struct {
+	ID          tftypes.String         `json:"-" tfsdk:"id"`
+	Name        string                 `json:"-" tfsdk:"name"`
+	Description *string                `json:"description,omitempty" tfsdk:"description"`
+	Addresses   []string               `json:"address,omitempty" tfsdk:"addresses"`
+	VLANAware   *Toggle                `json:"-" tfsdk:"vlan_aware"`
+	Ports       map[string]*SwitchPort `json:"-" tfsdk:"ports"`
+	VIFs        map[string]*SwitchVIF  `json:"vif,omitempty" tfsdk:"vifs"`
+}
+
+func (s *Switch) GetID() string {
+	return s.Name
+}
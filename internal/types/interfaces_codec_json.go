@@ -0,0 +1,154 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+func (b Bridge) MarshalJSON() ([]byte, error) {
+	var stp *string
+	if b.STP != nil {
+		s := strconv.FormatBool(*b.STP)
+		stp = &s
+	}
+
+	type Alias Bridge
+	return json.Marshal(&struct {
+		STP *string `json:"stp,omitempty"`
+		*Alias
+	}{
+		STP:   stp,
+		Alias: (*Alias)(&b),
+	})
+}
+
+func (b *Bridge) UnmarshalJSON(data []byte) error {
+	type Alias Bridge
+	aux := &struct {
+		STP string `json:"stp"`
+		*Alias
+	}{
+		Alias: (*Alias)(b),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("bridge", data, err)
+	}
+
+	// The router reports `false` when spanning tree was never turned on.
+	b.STP = nil
+	if aux.STP == "true" {
+		b.STP = boolptr(true)
+	}
+	return nil
+}
+
+type apiSwitchPortVLAN struct {
+	PVID *int     `json:"pvid,omitempty,string"`
+	VID  []string `json:"vid,omitempty"`
+}
+
+// MarshalJSON marshals a port without vlan settings as a valueless node, which
+// is how the router represents it.
+func (p *SwitchPort) MarshalJSON() ([]byte, error) {
+	if p.PVID == nil && len(p.VIDs) == 0 {
+		return []byte("null"), nil
+	}
+
+	vlan := &apiSwitchPortVLAN{PVID: p.PVID}
+	for _, vid := range p.VIDs {
+		vlan.VID = append(vlan.VID, strconv.Itoa(vid))
+	}
+
+	return json.Marshal(&struct {
+		VLAN *apiSwitchPortVLAN `json:"vlan"`
+	}{
+		VLAN: vlan,
+	})
+}
+
+func (p *SwitchPort) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		VLAN apiSwitchPortVLAN `json:"vlan"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("switch port", data, err)
+	}
+
+	p.PVID, p.VIDs = aux.VLAN.PVID, nil
+	for _, vid := range aux.VLAN.VID {
+		v, err := strconv.Atoi(vid)
+		if err != nil {
+			return malformed("switch port", data, err)
+		}
+		p.VIDs = append(p.VIDs, v)
+	}
+	return nil
+}
+
+// MarshalJSON marshals a vif without settings as a valueless node, which is
+// how the router represents it.
+func (v *SwitchVIF) MarshalJSON() ([]byte, error) {
+	if v.Description == nil && len(v.Addresses) == 0 {
+		return []byte("null"), nil
+	}
+
+	type Alias SwitchVIF
+	return json.Marshal((*Alias)(v))
+}
+
+type apiSwitchPorts struct {
+	Interface map[string]*SwitchPort `json:"interface,omitempty"`
+	VLANAware *Toggle                `json:"vlan-aware,omitempty"`
+}
+
+func (s Switch) MarshalJSON() ([]byte, error) {
+	var ports *apiSwitchPorts
+	if len(s.Ports) > 0 || s.VLANAware != nil {
+		ports = &apiSwitchPorts{
+			Interface: s.Ports,
+			VLANAware: s.VLANAware,
+		}
+	}
+
+	type Alias Switch
+	return json.Marshal(&struct {
+		SwitchPort *apiSwitchPorts `json:"switch-port,omitempty"`
+		*Alias
+	}{
+		SwitchPort: ports,
+		Alias:      (*Alias)(&s),
+	})
+}
+
+func (s *Switch) UnmarshalJSON(data []byte) error {
+	type Alias Switch
+	aux := &struct {
+		SwitchPort apiSwitchPorts `json:"switch-port"`
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("switch", data, err)
+	}
+
+	s.VLANAware = aux.SwitchPort.VLANAware
+	s.Ports = nil
+	if len(aux.SwitchPort.Interface) > 0 {
+		s.Ports = aux.SwitchPort.Interface
+	}
+
+	// Ports and vifs without settings are valueless nodes and therefore
+	// decoded as nil.
+	for name, port := range s.Ports {
+		if port == nil {
+			s.Ports[name] = &SwitchPort{}
+		}
+	}
+	for vid, vif := range s.VIFs {
+		if vif == nil {
+			s.VIFs[vid] = &SwitchVIF{}
+		}
+	}
+	return nil
+}
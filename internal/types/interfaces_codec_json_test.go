@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSwitchCodec(t *testing.T) {
+	enabled, pvid := Toggle(true), 10
+	expected := Switch{
+		Addresses: []string{"192.168.1.1/24"},
+		VLANAware: &enabled,
+		Ports: map[string]*SwitchPort{
+			"eth2": {
+				PVID: &pvid,
+				VIDs: []int{20, 30},
+			},
+			"eth3": {},
+		},
+		VIFs: map[string]*SwitchVIF{
+			"20": {
+				Addresses: []string{"192.168.20.1/24"},
+			},
+		},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"switch-port":{"interface":{"eth2":{"vlan":{"pvid":"10","vid":["20","30"]}},"eth3":null},"vlan-aware":"enable"},"address":["192.168.1.1/24"],"vif":{"20":{"address":["192.168.20.1/24"]}}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual Switch
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
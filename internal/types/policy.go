@@ -0,0 +1,59 @@
+package types
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrefixListRule struct {
+	Action      string  `json:"action" tfsdk:"action"`
+	Prefix      string  `json:"prefix" tfsdk:"prefix"`
+	Description *string `json:"description,omitempty" tfsdk:"description"`
+	GE          *int    `json:"ge,omitempty,string" tfsdk:"ge"`
+	LE          *int    `json:"le,omitempty,string" tfsdk:"le"`
+}
+
+type PrefixList struct {
+	ID          tftypes.String             `json:"-" tfsdk:"id"`
+	Name        string                     `json:"-" tfsdk:"name"`
+	Description *string                    `json:"description,omitempty" tfsdk:"description"`
+	Rules       map[string]*PrefixListRule `json:"rule,omitempty" tfsdk:"rules"`
+}
+
+func (l *PrefixList) GetID() string {
+	return l.Name
+}
+
+type RouteMapMatch struct {
+	PrefixList *string `json:"-" tfsdk:"prefix_list"`
+	ASPath     *string `json:"as-path,omitempty" tfsdk:"as_path"`
+	Interface  *string `json:"interface,omitempty" tfsdk:"interface"`
+	Metric     *int    `json:"metric,omitempty,string" tfsdk:"metric"`
+}
+
+type RouteMapSet struct {
+	LocalPreference *int    `json:"local-preference,omitempty,string" tfsdk:"local_preference"`
+	Metric          *int    `json:"metric,omitempty,string" tfsdk:"metric"`
+	Weight          *int    `json:"weight,omitempty,string" tfsdk:"weight"`
+	ASPathPrepend   *string `json:"as-path-prepend,omitempty" tfsdk:"as_path_prepend"`
+	Community       *string `json:"community,omitempty" tfsdk:"community"`
+	NextHop         *string `json:"ip-next-hop,omitempty" tfsdk:"next_hop"`
+	Origin          *string `json:"origin,omitempty" tfsdk:"origin"`
+}
+
+type RouteMapRule struct {
+	Action      string         `json:"action" tfsdk:"action"`
+	Description *string        `json:"description,omitempty" tfsdk:"description"`
+	Match       *RouteMapMatch `json:"match,omitempty" tfsdk:"match"`
+	Set         *RouteMapSet   `json:"set,omitempty" tfsdk:"set"`
+}
+
+type RouteMap struct {
+	ID          tftypes.String           `json:"-" tfsdk:"id"`
+	Name        string                   `json:"-" tfsdk:"name"`
+	Description *string                  `json:"description,omitempty" tfsdk:"description"`
+	Rules       map[string]*RouteMapRule `json:"rule,omitempty" tfsdk:"rules"`
+}
+
+func (m *RouteMap) GetID() string {
+	return m.Name
+}
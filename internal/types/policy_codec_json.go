@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type apiRouteMapIP struct {
+	Address *struct {
+		PrefixList *string `json:"prefix-list,omitempty"`
+	} `json:"address,omitempty"`
+}
+
+func (m *RouteMapMatch) MarshalJSON() ([]byte, error) {
+	var ip *apiRouteMapIP
+	if m.PrefixList != nil {
+		ip = &apiRouteMapIP{
+			Address: &struct {
+				PrefixList *string `json:"prefix-list,omitempty"`
+			}{
+				PrefixList: m.PrefixList,
+			},
+		}
+	}
+
+	type Alias RouteMapMatch
+	return json.Marshal(&struct {
+		IP *apiRouteMapIP `json:"ip,omitempty"`
+		*Alias
+	}{
+		IP:    ip,
+		Alias: (*Alias)(m),
+	})
+}
+
+func (m *RouteMapMatch) UnmarshalJSON(data []byte) error {
+	type Alias RouteMapMatch
+	aux := &struct {
+		IP apiRouteMapIP `json:"ip"`
+		*Alias
+	}{
+		Alias: (*Alias)(m),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("route map match", data, err)
+	}
+
+	m.PrefixList = nil
+	if aux.IP.Address != nil {
+		m.PrefixList = aux.IP.Address.PrefixList
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package types
+
+import (
+	"strconv"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StaticRoute struct {
+	NextHops  []string `json:"-" tfsdk:"next_hops"`
+	Blackhole *bool    `json:"-" tfsdk:"blackhole"`
+}
+
+type InterfaceRoute struct {
+	Interface string `json:"-" tfsdk:"interface"`
+}
+
+type StaticRouteTable struct {
+	ID              tftypes.String             `json:"-" tfsdk:"id"`
+	Table           int                        `json:"-" tfsdk:"table"`
+	Description     *string                    `json:"description,omitempty" tfsdk:"description"`
+	Routes          map[string]*StaticRoute    `json:"route,omitempty" tfsdk:"routes"`
+	InterfaceRoutes map[string]*InterfaceRoute `json:"interface-route,omitempty" tfsdk:"interface_routes"`
+}
+
+func (t *StaticRouteTable) GetID() string {
+	return strconv.Itoa(t.Table)
+}
+
+type RouteTest struct {
+	Type         string  `json:"-" tfsdk:"type"`
+	Target       *string `json:"-" tfsdk:"target"`
+	Script       *string `json:"-" tfsdk:"script"`
+	Interval     *int    `json:"interval,omitempty,string" tfsdk:"interval"`
+	SuccessCount *int    `json:"-" tfsdk:"success_count"`
+	FailureCount *int    `json:"-" tfsdk:"failure_count"`
+}
+
+type LoadBalanceInterface struct {
+	Weight       *int       `json:"weight,omitempty,string" tfsdk:"weight"`
+	FailoverOnly *bool      `json:"-" tfsdk:"failover_only"`
+	RouteTest    *RouteTest `json:"route-test,omitempty" tfsdk:"route_test"`
+}
+
+type Sticky struct {
+	SourceAddress      *Toggle `json:"source-addr,omitempty" tfsdk:"source_address"`
+	DestinationAddress *Toggle `json:"dest-addr,omitempty" tfsdk:"destination_address"`
+	SourcePort         *Toggle `json:"source-port,omitempty" tfsdk:"source_port"`
+	DestinationPort    *Toggle `json:"dest-port,omitempty" tfsdk:"destination_port"`
+	Protocol           *Toggle `json:"protocol,omitempty" tfsdk:"protocol"`
+}
+
+type LoadBalanceGroup struct {
+	ID                  tftypes.String                   `json:"-" tfsdk:"id"`
+	Name                string                           `json:"-" tfsdk:"name"`
+	Interfaces          map[string]*LoadBalanceInterface `json:"interface,omitempty" tfsdk:"interfaces"`
+	Sticky              *Sticky                          `json:"sticky,omitempty" tfsdk:"sticky"`
+	LBLocal             *Toggle                          `json:"lb-local,omitempty" tfsdk:"lb_local"`
+	LBLocalMetricChange *Toggle                          `json:"lb-local-metric-change,omitempty" tfsdk:"lb_local_metric_change"`
+	FlushOnActive       *Toggle                          `json:"flush-on-active,omitempty" tfsdk:"flush_on_active"`
+}
+
+func (g *LoadBalanceGroup) GetID() string {
+	return g.Name
+}
+
+// OSPFID is the identifier of the OSPF process. There is only one per router.
+const OSPFID = "ospf"
+
+type OSPFArea struct {
+	Type     *string  `json:"-" tfsdk:"type"`
+	Networks []string `json:"network,omitempty" tfsdk:"networks"`
+}
+
+type OSPFParameters struct {
+	RouterID             *string `json:"router-id,omitempty" tfsdk:"router_id"`
+	ABRType              *string `json:"abr-type,omitempty" tfsdk:"abr_type"`
+	RFC1583Compatibility *bool   `json:"-" tfsdk:"rfc1583_compatibility"`
+}
+
+type Redistribution struct {
+	Metric     *int    `json:"metric,omitempty,string" tfsdk:"metric"`
+	MetricType *int    `json:"metric-type,omitempty,string" tfsdk:"metric_type"`
+	RouteMap   *string `json:"route-map,omitempty" tfsdk:"route_map"`
+}
+
+type OSPFRedistribute struct {
+	BGP       *Redistribution `json:"bgp,omitempty" tfsdk:"bgp"`
+	Connected *Redistribution `json:"connected,omitempty" tfsdk:"connected"`
+	Kernel    *Redistribution `json:"kernel,omitempty" tfsdk:"kernel"`
+	Static    *Redistribution `json:"static,omitempty" tfsdk:"static"`
+}
+
+type OSPF struct {
+	ID                tftypes.String       `json:"-" tfsdk:"id"`
+	Areas             map[string]*OSPFArea `json:"area,omitempty" tfsdk:"areas"`
+	PassiveInterfaces []string             `json:"passive-interface,omitempty" tfsdk:"passive_interfaces"`
+	Redistribute      *OSPFRedistribute    `json:"redistribute,omitempty" tfsdk:"redistribute"`
+	Parameters        *OSPFParameters      `json:"parameters,omitempty" tfsdk:"parameters"`
+}
+
+func (o *OSPF) GetID() string {
+	return OSPFID
+}
+
+type BGPRedistribution struct {
+	Metric   *int    `json:"metric,omitempty,string" tfsdk:"metric"`
+	RouteMap *string `json:"route-map,omitempty" tfsdk:"route_map"`
+}
+
+type BGPRedistribute struct {
+	Connected *BGPRedistribution `json:"connected,omitempty" tfsdk:"connected"`
+	Kernel    *BGPRedistribution `json:"kernel,omitempty" tfsdk:"kernel"`
+	OSPF      *BGPRedistribution `json:"ospf,omitempty" tfsdk:"ospf"`
+	Static    *BGPRedistribution `json:"static,omitempty" tfsdk:"static"`
+}
+
+// BGP is the BGP process of the router. Its neighbors are managed separately
+// with BGPNeighbor.
+type BGP struct {
+	ID           tftypes.String   `json:"-" tfsdk:"id"`
+	ASN          int              `json:"-" tfsdk:"asn"`
+	RouterID     *string          `json:"-" tfsdk:"router_id"`
+	Networks     []string         `json:"-" tfsdk:"networks"`
+	Redistribute *BGPRedistribute `json:"redistribute,omitempty" tfsdk:"redistribute"`
+}
+
+func (b *BGP) GetID() string {
+	return strconv.Itoa(b.ASN)
+}
+
+type BGPFilter struct {
+	Import *string `json:"import,omitempty" tfsdk:"import"`
+	Export *string `json:"export,omitempty" tfsdk:"export"`
+}
+
+type BGPNeighbor struct {
+	ID           tftypes.String `json:"-" tfsdk:"id"`
+	ASN          int            `json:"-" tfsdk:"asn"`
+	Address      string         `json:"-" tfsdk:"address"`
+	RemoteAS     int            `json:"remote-as,string" tfsdk:"remote_as"`
+	Description  *string        `json:"description,omitempty" tfsdk:"description"`
+	Password     *string        `json:"password,omitempty" tfsdk:"password"`
+	UpdateSource *string        `json:"update-source,omitempty" tfsdk:"update_source"`
+	EBGPMultihop *int           `json:"ebgp-multihop,omitempty,string" tfsdk:"ebgp_multihop"`
+	NextHopSelf  *bool          `json:"-" tfsdk:"next_hop_self"`
+	Shutdown     *bool          `json:"-" tfsdk:"shutdown"`
+	RouteMap     *BGPFilter     `json:"route-map,omitempty" tfsdk:"route_map"`
+	PrefixList   *BGPFilter     `json:"prefix-list,omitempty" tfsdk:"prefix_list"`
+}
+
+// GetID returns the local AS number and the address of the neighbor separated
+// by a slash, as neighbors are configured per BGP process.
+func (n *BGPNeighbor) GetID() string {
+	return strconv.Itoa(n.ASN) + "/" + n.Address
+}
+
+// IGMPProxyID is the identifier of the IGMP proxy. There is only one per
+// router.
+const IGMPProxyID = "igmp-proxy"
+
+type IGMPProxyInterface struct {
+	Role       string   `json:"role" tfsdk:"role"`
+	AltSubnets []string `json:"alt-subnet,omitempty" tfsdk:"alt_subnets"`
+	Threshold  *int     `json:"threshold,omitempty,string" tfsdk:"threshold"`
+}
+
+type IGMPProxy struct {
+	ID         tftypes.String                 `json:"-" tfsdk:"id"`
+	Interfaces map[string]*IGMPProxyInterface `json:"interface,omitempty" tfsdk:"interfaces"`
+}
+
+func (p *IGMPProxy) GetID() string {
+	return IGMPProxyID
+}
@@ -0,0 +1,400 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+func (r *StaticRoute) MarshalJSON() ([]byte, error) {
+	var hops map[string]*flag
+	if len(r.NextHops) > 0 {
+		hops = map[string]*flag{}
+		for _, hop := range r.NextHops {
+			hops[hop] = &flag{present: true}
+		}
+	}
+
+	return json.Marshal(&struct {
+		NextHop   map[string]*flag `json:"next-hop,omitempty"`
+		Blackhole *flag            `json:"blackhole,omitempty"`
+	}{
+		NextHop:   hops,
+		Blackhole: toFlag(r.Blackhole),
+	})
+}
+
+func (r *StaticRoute) UnmarshalJSON(data []byte) error {
+	aux := &struct {
+		NextHop   map[string]json.RawMessage `json:"next-hop"`
+		Blackhole flag                       `json:"blackhole"`
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("static route", data, err)
+	}
+
+	r.NextHops = nil
+	for hop := range aux.NextHop {
+		r.NextHops = append(r.NextHops, hop)
+	}
+	sortedKeys(r.NextHops)
+	r.Blackhole = aux.Blackhole.value()
+	return nil
+}
+
+func (r *InterfaceRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		NextHopInterface map[string]*flag `json:"next-hop-interface"`
+	}{
+		NextHopInterface: map[string]*flag{
+			r.Interface: {present: true},
+		},
+	})
+}
+
+func (r *InterfaceRoute) UnmarshalJSON(data []byte) error {
+	aux := &struct {
+		NextHopInterface map[string]json.RawMessage `json:"next-hop-interface"`
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("interface route", data, err)
+	}
+
+	keys := []string{}
+	for k := range aux.NextHopInterface {
+		keys = append(keys, k)
+	}
+	r.Interface = ""
+	if len(keys) > 0 {
+		r.Interface = sortedKeys(keys)[0]
+	}
+	return nil
+}
+
+type apiRouteTestType struct {
+	Ping *struct {
+		Target string `json:"target"`
+	} `json:"ping,omitempty"`
+	Default *flag   `json:"default,omitempty"`
+	Script  *string `json:"script,omitempty"`
+}
+
+type apiRouteTestCount struct {
+	Success *int `json:"success,omitempty,string"`
+	Failure *int `json:"failure,omitempty,string"`
+}
+
+func (t *RouteTest) MarshalJSON() ([]byte, error) {
+	typ := &apiRouteTestType{}
+	switch t.Type {
+	case "ping":
+		typ.Ping = &struct {
+			Target string `json:"target"`
+		}{}
+		if t.Target != nil {
+			typ.Ping.Target = *t.Target
+		}
+	case "script":
+		typ.Script = t.Script
+	default:
+		typ.Default = &flag{present: true}
+	}
+
+	var count *apiRouteTestCount
+	if t.SuccessCount != nil || t.FailureCount != nil {
+		count = &apiRouteTestCount{
+			Success: t.SuccessCount,
+			Failure: t.FailureCount,
+		}
+	}
+
+	type Alias RouteTest
+	return json.Marshal(&struct {
+		Type  *apiRouteTestType  `json:"type"`
+		Count *apiRouteTestCount `json:"count,omitempty"`
+		*Alias
+	}{
+		Type:  typ,
+		Count: count,
+		Alias: (*Alias)(t),
+	})
+}
+
+func (t *RouteTest) UnmarshalJSON(data []byte) error {
+	type Alias RouteTest
+	aux := &struct {
+		Type struct {
+			Ping *struct {
+				Target string `json:"target"`
+			} `json:"ping"`
+			Script *string `json:"script"`
+		} `json:"type"`
+		Count apiRouteTestCount `json:"count"`
+		*Alias
+	}{
+		Alias: (*Alias)(t),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("route test", data, err)
+	}
+
+	t.Type, t.Target, t.Script = "default", nil, nil
+	switch {
+	case aux.Type.Ping != nil:
+		t.Type, t.Target = "ping", &aux.Type.Ping.Target
+	case aux.Type.Script != nil:
+		t.Type, t.Script = "script", aux.Type.Script
+	}
+
+	t.SuccessCount = aux.Count.Success
+	t.FailureCount = aux.Count.Failure
+	return nil
+}
+
+func (i *LoadBalanceInterface) MarshalJSON() ([]byte, error) {
+	type Alias LoadBalanceInterface
+	return json.Marshal(&struct {
+		FailoverOnly *flag `json:"failover-only,omitempty"`
+		*Alias
+	}{
+		FailoverOnly: toFlag(i.FailoverOnly),
+		Alias:        (*Alias)(i),
+	})
+}
+
+func (i *LoadBalanceInterface) UnmarshalJSON(data []byte) error {
+	type Alias LoadBalanceInterface
+	aux := &struct {
+		FailoverOnly flag `json:"failover-only"`
+		*Alias
+	}{
+		Alias: (*Alias)(i),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("load balance interface", data, err)
+	}
+
+	i.FailoverOnly = aux.FailoverOnly.value()
+	return nil
+}
+
+func (a *OSPFArea) MarshalJSON() ([]byte, error) {
+	var typ map[string]*flag
+	if a.Type != nil {
+		typ = map[string]*flag{*a.Type: {present: true}}
+	}
+
+	type Alias OSPFArea
+	return json.Marshal(&struct {
+		Type map[string]*flag `json:"area-type,omitempty"`
+		*Alias
+	}{
+		Type:  typ,
+		Alias: (*Alias)(a),
+	})
+}
+
+func (a *OSPFArea) UnmarshalJSON(data []byte) error {
+	type Alias OSPFArea
+	aux := &struct {
+		Type map[string]json.RawMessage `json:"area-type"`
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("ospf area", data, err)
+	}
+
+	a.Type = nil
+	for typ := range aux.Type {
+		t := typ
+		a.Type = &t
+	}
+	return nil
+}
+
+func (p *OSPFParameters) MarshalJSON() ([]byte, error) {
+	type Alias OSPFParameters
+	return json.Marshal(&struct {
+		RFC1583Compatibility *flag `json:"rfc1583-compatibility,omitempty"`
+		*Alias
+	}{
+		RFC1583Compatibility: toFlag(p.RFC1583Compatibility),
+		Alias:                (*Alias)(p),
+	})
+}
+
+func (p *OSPFParameters) UnmarshalJSON(data []byte) error {
+	type Alias OSPFParameters
+	aux := &struct {
+		RFC1583Compatibility flag `json:"rfc1583-compatibility"`
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("ospf parameters", data, err)
+	}
+
+	p.RFC1583Compatibility = aux.RFC1583Compatibility.value()
+	return nil
+}
+
+// MarshalJSON marshals a redistribution without settings as a valueless node,
+// which is how the router represents it.
+func (r *Redistribution) MarshalJSON() ([]byte, error) {
+	if *r == (Redistribution{}) {
+		return []byte("null"), nil
+	}
+
+	type Alias Redistribution
+	return json.Marshal((*Alias)(r))
+}
+
+// UnmarshalJSON keeps redistributions that are valueless nodes, which would
+// otherwise be dropped because they are null.
+func (r *OSPFRedistribute) UnmarshalJSON(data []byte) error {
+	var aux map[string]json.RawMessage
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("ospf redistribute", data, err)
+	}
+
+	*r = OSPFRedistribute{}
+	for protocol, dst := range map[string]**Redistribution{
+		"bgp":       &r.BGP,
+		"connected": &r.Connected,
+		"kernel":    &r.Kernel,
+		"static":    &r.Static,
+	} {
+		raw, ok := aux[protocol]
+		if !ok {
+			continue
+		}
+		*dst = &Redistribution{}
+		if err := json.Unmarshal(raw, *dst); err != nil {
+			return malformed("ospf redistribute", data, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON marshals a redistribution without settings as a valueless node,
+// which is how the router represents it.
+func (r *BGPRedistribution) MarshalJSON() ([]byte, error) {
+	if *r == (BGPRedistribution{}) {
+		return []byte("null"), nil
+	}
+
+	type Alias BGPRedistribution
+	return json.Marshal((*Alias)(r))
+}
+
+// UnmarshalJSON keeps redistributions that are valueless nodes, which would
+// otherwise be dropped because they are null.
+func (r *BGPRedistribute) UnmarshalJSON(data []byte) error {
+	var aux map[string]json.RawMessage
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("bgp redistribute", data, err)
+	}
+
+	*r = BGPRedistribute{}
+	for protocol, dst := range map[string]**BGPRedistribution{
+		"connected": &r.Connected,
+		"kernel":    &r.Kernel,
+		"ospf":      &r.OSPF,
+		"static":    &r.Static,
+	} {
+		raw, ok := aux[protocol]
+		if !ok {
+			continue
+		}
+		*dst = &BGPRedistribution{}
+		if err := json.Unmarshal(raw, *dst); err != nil {
+			return malformed("bgp redistribute", data, err)
+		}
+	}
+	return nil
+}
+
+type apiBGPParameters struct {
+	RouterID *string `json:"router-id,omitempty"`
+}
+
+func (b *BGP) MarshalJSON() ([]byte, error) {
+	var parameters *apiBGPParameters
+	if b.RouterID != nil {
+		parameters = &apiBGPParameters{RouterID: b.RouterID}
+	}
+
+	var networks map[string]*flag
+	if len(b.Networks) > 0 {
+		networks = map[string]*flag{}
+		for _, network := range b.Networks {
+			networks[network] = &flag{present: true}
+		}
+	}
+
+	type Alias BGP
+	return json.Marshal(&struct {
+		Parameters *apiBGPParameters `json:"parameters,omitempty"`
+		Networks   map[string]*flag  `json:"network,omitempty"`
+		*Alias
+	}{
+		Parameters: parameters,
+		Networks:   networks,
+		Alias:      (*Alias)(b),
+	})
+}
+
+func (b *BGP) UnmarshalJSON(data []byte) error {
+	type Alias BGP
+	aux := &struct {
+		Parameters apiBGPParameters           `json:"parameters"`
+		Networks   map[string]json.RawMessage `json:"network"`
+		*Alias
+	}{
+		Alias: (*Alias)(b),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("bgp", data, err)
+	}
+
+	b.RouterID = aux.Parameters.RouterID
+
+	b.Networks = nil
+	for network := range aux.Networks {
+		b.Networks = append(b.Networks, network)
+	}
+	sortedKeys(b.Networks)
+	return nil
+}
+
+func (n *BGPNeighbor) MarshalJSON() ([]byte, error) {
+	type Alias BGPNeighbor
+	return json.Marshal(&struct {
+		NextHopSelf *flag `json:"nexthop-self,omitempty"`
+		Shutdown    *flag `json:"shutdown,omitempty"`
+		*Alias
+	}{
+		NextHopSelf: toFlag(n.NextHopSelf),
+		Shutdown:    toFlag(n.Shutdown),
+		Alias:       (*Alias)(n),
+	})
+}
+
+func (n *BGPNeighbor) UnmarshalJSON(data []byte) error {
+	type Alias BGPNeighbor
+	aux := &struct {
+		NextHopSelf flag `json:"nexthop-self"`
+		Shutdown    flag `json:"shutdown"`
+		*Alias
+	}{
+		Alias: (*Alias)(n),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("bgp neighbor", data, err)
+	}
+
+	n.NextHopSelf = aux.NextHopSelf.value()
+	n.Shutdown = aux.Shutdown.value()
+	return nil
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestLoadBalanceGroupCodec(t *testing.T) {
+	weight, interval, failures := 60, 10, 3
+	target := "8.8.8.8"
+	enabled := Toggle(true)
+	expected := LoadBalanceGroup{
+		Interfaces: map[string]*LoadBalanceInterface{
+			"eth0": {
+				Weight: &weight,
+				RouteTest: &RouteTest{
+					Type:         "ping",
+					Target:       &target,
+					Interval:     &interval,
+					FailureCount: &failures,
+				},
+			},
+			"eth1": {
+				FailoverOnly: boolptr(true),
+				RouteTest: &RouteTest{
+					Type: "default",
+				},
+			},
+		},
+		Sticky: &Sticky{
+			SourceAddress: &enabled,
+		},
+		LBLocal: &enabled,
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"interface":{"eth0":{"weight":"60","route-test":{"type":{"ping":{"target":"8.8.8.8"}},"count":{"failure":"3"},"interval":"10"}},"eth1":{"failover-only":null,"route-test":{"type":{"default":null}}}},"sticky":{"source-addr":"enable"},"lb-local":"enable"}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual LoadBalanceGroup
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestOSPFCodec(t *testing.T) {
+	stub, metric := "stub", 20
+	expected := OSPF{
+		Areas: map[string]*OSPFArea{
+			"0": {
+				Networks: []string{"10.0.0.0/24"},
+			},
+			"1": {
+				Type:     &stub,
+				Networks: []string{"10.0.1.0/24"},
+			},
+		},
+		Redistribute: &OSPFRedistribute{
+			Connected: &Redistribution{},
+			Static: &Redistribution{
+				Metric: &metric,
+			},
+		},
+		Parameters: &OSPFParameters{
+			RFC1583Compatibility: boolptr(true),
+		},
+	}
+
+	data, err := json.Marshal(&expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"area":{"0":{"network":["10.0.0.0/24"]},"1":{"area-type":{"stub":null},"network":["10.0.1.0/24"]}},"redistribute":{"connected":null,"static":{"metric":"20"}},"parameters":{"rfc1583-compatibility":null}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual OSPF
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestBGPCodec(t *testing.T) {
+	routerID := "10.0.0.1"
+	expected := BGP{
+		RouterID: &routerID,
+		Networks: []string{"192.0.2.0/24", "198.51.100.0/24"},
+	}
+
+	data, err := json.Marshal(&expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"parameters":{"router-id":"10.0.0.1"},"network":{"192.0.2.0/24":null,"198.51.100.0/24":null}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	// The neighbors are managed by a separate resource and must be ignored.
+	const actualTree = `{"neighbor":{"192.0.2.1":{"remote-as":"65001"}},"network":{"198.51.100.0/24":null,"192.0.2.0/24":null},"parameters":{"router-id":"10.0.0.1"}}`
+	var actual BGP
+	if err := json.Unmarshal([]byte(actualTree), &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
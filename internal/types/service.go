@@ -0,0 +1,121 @@
+package types
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SNMPID is the identifier of the SNMP service. There is only one per router.
+const SNMPID = "snmp"
+
+type SNMPCommunity struct {
+	Authorization *string  `json:"authorization,omitempty" tfsdk:"authorization"`
+	Clients       []string `json:"client,omitempty" tfsdk:"clients"`
+	Networks      []string `json:"network,omitempty" tfsdk:"networks"`
+}
+
+type SNMPv3User struct {
+	Mode        *string `json:"mode,omitempty" tfsdk:"mode"`
+	AuthType    string  `json:"-" tfsdk:"auth_type"`
+	AuthKey     string  `json:"-" tfsdk:"auth_key"`
+	PrivacyType *string `json:"-" tfsdk:"privacy_type"`
+	PrivacyKey  *string `json:"-" tfsdk:"privacy_key"`
+}
+
+type SNMP struct {
+	ID              tftypes.String            `json:"-" tfsdk:"id"`
+	Contact         *string                   `json:"contact,omitempty" tfsdk:"contact"`
+	Location        *string                   `json:"location,omitempty" tfsdk:"location"`
+	Communities     map[string]*SNMPCommunity `json:"community,omitempty" tfsdk:"communities"`
+	ListenAddresses []string                  `json:"-" tfsdk:"listen_addresses"`
+	V3Users         map[string]*SNMPv3User    `json:"-" tfsdk:"v3_users"`
+}
+
+func (s *SNMP) GetID() string {
+	return SNMPID
+}
+
+// SSHID is the identifier of the SSH service. There is only one per router.
+const SSHID = "ssh"
+
+type SSH struct {
+	ID                            tftypes.String `json:"-" tfsdk:"id"`
+	Port                          *int           `json:"port,omitempty,string" tfsdk:"port"`
+	ListenAddresses               []string       `json:"listen-address,omitempty" tfsdk:"listen_addresses"`
+	ProtocolVersion               *string        `json:"protocol-version,omitempty" tfsdk:"protocol_version"`
+	DisablePasswordAuthentication *bool          `json:"-" tfsdk:"disable_password_authentication"`
+	AllowRoot                     *bool          `json:"-" tfsdk:"allow_root"`
+}
+
+func (s *SSH) GetID() string {
+	return SSHID
+}
+
+// GUIID is the identifier of the web interface. There is only one per router.
+const GUIID = "gui"
+
+type GUI struct {
+	ID            tftypes.String `json:"-" tfsdk:"id"`
+	HTTPPort      *int           `json:"http-port,omitempty,string" tfsdk:"http_port"`
+	HTTPSPort     *int           `json:"https-port,omitempty,string" tfsdk:"https_port"`
+	ListenAddress *string        `json:"listen-address,omitempty" tfsdk:"listen_address"`
+	OlderCiphers  *Toggle        `json:"older-ciphers,omitempty" tfsdk:"older_ciphers"`
+	CertFile      *string        `json:"cert-file,omitempty" tfsdk:"cert_file"`
+}
+
+func (g *GUI) GetID() string {
+	return GUIID
+}
+
+// UPnPID is the identifier of the UPnP service. There is only one per router.
+const UPnPID = "upnp2"
+
+type UPnPRule struct {
+	Action       string  `json:"action" tfsdk:"action"`
+	Description  *string `json:"description,omitempty" tfsdk:"description"`
+	Subnet       *string `json:"subnet,omitempty" tfsdk:"subnet"`
+	LocalPort    *string `json:"local-port,omitempty" tfsdk:"local_port"`
+	ExternalPort *string `json:"external-port,omitempty" tfsdk:"external_port"`
+}
+
+type UPnP struct {
+	ID           tftypes.String       `json:"-" tfsdk:"id"`
+	WANInterface string               `json:"wan" tfsdk:"wan_interface"`
+	ListenOn     []string             `json:"listen-on,omitempty" tfsdk:"listen_on"`
+	NATPMP       *Toggle              `json:"nat-pmp,omitempty" tfsdk:"nat_pmp"`
+	SecureMode   *Toggle              `json:"secure-mode,omitempty" tfsdk:"secure_mode"`
+	Rules        map[string]*UPnPRule `json:"-" tfsdk:"acl"`
+}
+
+func (u *UPnP) GetID() string {
+	return UPnPID
+}
+
+// MDNSRepeaterID is the identifier of the mDNS repeater. There is only one per
+// router.
+const MDNSRepeaterID = "mdns-repeater"
+
+type MDNSRepeater struct {
+	ID         tftypes.String `json:"-" tfsdk:"id"`
+	Interfaces []string       `json:"interface,omitempty" tfsdk:"interfaces"`
+}
+
+func (m *MDNSRepeater) GetID() string {
+	return MDNSRepeaterID
+}
+
+type DynamicDNS struct {
+	ID        tftypes.String `json:"-" tfsdk:"id"`
+	Interface string         `json:"-" tfsdk:"interface"`
+	Service   string         `json:"-" tfsdk:"service"`
+	HostNames []string       `json:"host-name,omitempty" tfsdk:"host_names"`
+	Login     *string        `json:"login,omitempty" tfsdk:"login"`
+	Password  *string        `json:"password,omitempty" tfsdk:"password"`
+	Server    *string        `json:"server,omitempty" tfsdk:"server"`
+	Protocol  *string        `json:"protocol,omitempty" tfsdk:"protocol"`
+}
+
+// GetID returns the interface and the service separated by a slash, as an
+// interface may be registered with several services.
+func (d *DynamicDNS) GetID() string {
+	return d.Interface + "/" + d.Service
+}
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type apiSNMPKey struct {
+	Type         string `json:"type,omitempty"`
+	PlaintextKey string `json:"plaintext-key,omitempty"`
+}
+
+func (u *SNMPv3User) MarshalJSON() ([]byte, error) {
+	var privacy *apiSNMPKey
+	if u.PrivacyType != nil || u.PrivacyKey != nil {
+		privacy = &apiSNMPKey{}
+		if u.PrivacyType != nil {
+			privacy.Type = *u.PrivacyType
+		}
+		if u.PrivacyKey != nil {
+			privacy.PlaintextKey = *u.PrivacyKey
+		}
+	}
+
+	type Alias SNMPv3User
+	return json.Marshal(&struct {
+		Auth    *apiSNMPKey `json:"auth,omitempty"`
+		Privacy *apiSNMPKey `json:"privacy,omitempty"`
+		*Alias
+	}{
+		Auth: &apiSNMPKey{
+			Type:         u.AuthType,
+			PlaintextKey: u.AuthKey,
+		},
+		Privacy: privacy,
+		Alias:   (*Alias)(u),
+	})
+}
+
+func (u *SNMPv3User) UnmarshalJSON(data []byte) error {
+	type Alias SNMPv3User
+	aux := &struct {
+		Auth    apiSNMPKey  `json:"auth"`
+		Privacy *apiSNMPKey `json:"privacy"`
+		*Alias
+	}{
+		Alias: (*Alias)(u),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("snmp v3 user", data, err)
+	}
+
+	// The router replaces plaintext keys with encrypted ones once they are
+	// committed. These are filled in from the state by the resource.
+	u.AuthType, u.AuthKey = aux.Auth.Type, aux.Auth.PlaintextKey
+	u.PrivacyType, u.PrivacyKey = nil, nil
+	if aux.Privacy != nil {
+		if aux.Privacy.Type != "" {
+			u.PrivacyType = &aux.Privacy.Type
+		}
+		if aux.Privacy.PlaintextKey != "" {
+			u.PrivacyKey = &aux.Privacy.PlaintextKey
+		}
+	}
+	return nil
+}
+
+func (s SNMP) MarshalJSON() ([]byte, error) {
+	var listen map[string]*flag
+	if len(s.ListenAddresses) > 0 {
+		listen = map[string]*flag{}
+		for _, address := range s.ListenAddresses {
+			listen[address] = &flag{present: true}
+		}
+	}
+
+	var v3 map[string]interface{}
+	if len(s.V3Users) > 0 {
+		v3 = map[string]interface{}{
+			"user": s.V3Users,
+		}
+	}
+
+	type Alias SNMP
+	return json.Marshal(&struct {
+		ListenAddress map[string]*flag       `json:"listen-address,omitempty"`
+		V3            map[string]interface{} `json:"v3,omitempty"`
+		*Alias
+	}{
+		ListenAddress: listen,
+		V3:            v3,
+		Alias:         (*Alias)(&s),
+	})
+}
+
+func (s *SNMP) UnmarshalJSON(data []byte) error {
+	type Alias SNMP
+	aux := &struct {
+		ListenAddress map[string]json.RawMessage `json:"listen-address"`
+		V3            struct {
+			User map[string]*SNMPv3User `json:"user"`
+		} `json:"v3"`
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("snmp", data, err)
+	}
+
+	s.ListenAddresses = nil
+	for address := range aux.ListenAddress {
+		s.ListenAddresses = append(s.ListenAddresses, address)
+	}
+	sortedKeys(s.ListenAddresses)
+
+	s.V3Users = nil
+	if len(aux.V3.User) > 0 {
+		s.V3Users = aux.V3.User
+	}
+	return nil
+}
+
+func (s SSH) MarshalJSON() ([]byte, error) {
+	type Alias SSH
+	return json.Marshal(&struct {
+		DisablePasswordAuthentication *flag `json:"disable-password-authentication,omitempty"`
+		AllowRoot                     *flag `json:"allow-root,omitempty"`
+		*Alias
+	}{
+		DisablePasswordAuthentication: toFlag(s.DisablePasswordAuthentication),
+		AllowRoot:                     toFlag(s.AllowRoot),
+		Alias:                         (*Alias)(&s),
+	})
+}
+
+func (s *SSH) UnmarshalJSON(data []byte) error {
+	type Alias SSH
+	aux := &struct {
+		DisablePasswordAuthentication flag `json:"disable-password-authentication"`
+		AllowRoot                     flag `json:"allow-root"`
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("ssh", data, err)
+	}
+
+	s.DisablePasswordAuthentication = aux.DisablePasswordAuthentication.value()
+	s.AllowRoot = aux.AllowRoot.value()
+	return nil
+}
+
+type apiUPnPACL struct {
+	Rule map[string]*UPnPRule `json:"rule,omitempty"`
+}
+
+func (u UPnP) MarshalJSON() ([]byte, error) {
+	var acl *apiUPnPACL
+	if len(u.Rules) > 0 {
+		acl = &apiUPnPACL{Rule: u.Rules}
+	}
+
+	type Alias UPnP
+	return json.Marshal(&struct {
+		ACL *apiUPnPACL `json:"acl,omitempty"`
+		*Alias
+	}{
+		ACL:   acl,
+		Alias: (*Alias)(&u),
+	})
+}
+
+func (u *UPnP) UnmarshalJSON(data []byte) error {
+	type Alias UPnP
+	aux := &struct {
+		ACL apiUPnPACL `json:"acl"`
+		*Alias
+	}{
+		Alias: (*Alias)(u),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("upnp", data, err)
+	}
+
+	u.Rules = nil
+	if len(aux.ACL.Rule) > 0 {
+		u.Rules = aux.ACL.Rule
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSSHCodec(t *testing.T) {
+	port := 2222
+	expected := SSH{
+		Port:                          &port,
+		ListenAddresses:               []string{"192.168.1.1"},
+		DisablePasswordAuthentication: boolptr(true),
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"disable-password-authentication":null,"port":"2222","listen-address":["192.168.1.1"]}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual SSH
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestUPnPCodec(t *testing.T) {
+	enabled, subnet, port := Toggle(true), "192.168.1.0/24", "1024-65535"
+	expected := UPnP{
+		WANInterface: "eth0",
+		ListenOn:     []string{"eth1"},
+		NATPMP:       &enabled,
+		Rules: map[string]*UPnPRule{
+			"10": {
+				Action:    "allow",
+				Subnet:    &subnet,
+				LocalPort: &port,
+			},
+		},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"acl":{"rule":{"10":{"action":"allow","subnet":"192.168.1.0/24","local-port":"1024-65535"}}},"wan":"eth0","listen-on":["eth1"],"nat-pmp":"enable"}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual UPnP
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestDynamicDNSCodec(t *testing.T) {
+	login, password, server, protocol := "admin@example.com", "secret", "api.cloudflare.com/client/v4", "cloudflare"
+	expected := DynamicDNS{
+		HostNames: []string{"branch.example.com", "office.example.com"},
+		Login:     &login,
+		Password:  &password,
+		Server:    &server,
+		Protocol:  &protocol,
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"host-name":["branch.example.com","office.example.com"],"login":"admin@example.com","password":"secret","server":"api.cloudflare.com/client/v4","protocol":"cloudflare"}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual DynamicDNS
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"net"
+	"strconv"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type User struct {
+	ID                tftypes.String `json:"-" tfsdk:"id"`
+	Name              string         `json:"-" tfsdk:"name"`
+	FullName          *string        `json:"full-name,omitempty" tfsdk:"full_name"`
+	Level             *string        `json:"level,omitempty" tfsdk:"level"`
+	Password          *string        `json:"-" tfsdk:"password"`
+	EncryptedPassword *string        `json:"-" tfsdk:"encrypted_password"`
+}
+
+type SSHKey struct {
+	ID      tftypes.String `json:"-" tfsdk:"id"`
+	User    string         `json:"-" tfsdk:"user"`
+	Name    string         `json:"-" tfsdk:"name"`
+	Type    string         `json:"type" tfsdk:"type"`
+	Key     string         `json:"key" tfsdk:"key"`
+	Options *string        `json:"options,omitempty" tfsdk:"options"`
+}
+
+func (u *User) GetID() string {
+	return u.Name
+}
+
+// GetID returns the user and the name of the key separated by a slash, as key
+// names are only unique per user.
+func (k *SSHKey) GetID() string {
+	return k.User + "/" + k.Name
+}
+
+// SystemID is the identifier of the basic system settings. There is only one
+// set per router.
+const SystemID = "system"
+
+type OffloadFamily struct {
+	Forwarding *Toggle `json:"forwarding,omitempty" tfsdk:"forwarding"`
+	VLAN       *Toggle `json:"vlan,omitempty" tfsdk:"vlan"`
+	PPPoE      *Toggle `json:"pppoe,omitempty" tfsdk:"pppoe"`
+}
+
+type Offload struct {
+	IPv4  *OffloadFamily `json:"ipv4,omitempty" tfsdk:"ipv4"`
+	IPv6  *OffloadFamily `json:"ipv6,omitempty" tfsdk:"ipv6"`
+	IPsec *Toggle        `json:"ipsec,omitempty" tfsdk:"ipsec"`
+}
+
+type System struct {
+	ID          tftypes.String `json:"-" tfsdk:"id"`
+	HostName    *string        `json:"host-name,omitempty" tfsdk:"host_name"`
+	DomainName  *string        `json:"domain-name,omitempty" tfsdk:"domain_name"`
+	TimeZone    *string        `json:"time-zone,omitempty" tfsdk:"time_zone"`
+	NTPServers  []string       `json:"-" tfsdk:"ntp_servers"`
+	NameServers []string       `json:"name-server,omitempty" tfsdk:"name_servers"`
+	Offload     *Offload       `json:"offload,omitempty" tfsdk:"offload"`
+}
+
+func (s *System) GetID() string {
+	return SystemID
+}
+
+type SyslogHost struct {
+	ID       tftypes.String `json:"-" tfsdk:"id"`
+	Host     string         `json:"-" tfsdk:"host"`
+	Port     *int           `json:"-" tfsdk:"port"`
+	Facility string         `json:"-" tfsdk:"facility"`
+	Level    string         `json:"-" tfsdk:"level"`
+}
+
+// GetID returns the name of the configuration node which is the host,
+// optionally followed by a colon and the port.
+func (h *SyslogHost) GetID() string {
+	if h.Port == nil {
+		return h.Host
+	}
+	return net.JoinHostPort(h.Host, strconv.Itoa(*h.Port))
+}
+
+// ParseSyslogHostID is the inverse of GetID.
+func ParseSyslogHostID(id string) (string, *int) {
+	host, port, err := net.SplitHostPort(id)
+	if err != nil {
+		return id, nil
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return id, nil
+	}
+	return host, &p
+}
+
+// ConntrackID is the identifier of the connection tracking settings. There is
+// only one set per router.
+const ConntrackID = "conntrack"
+
+type ConntrackTCPTimeouts struct {
+	Close       *int `json:"close,omitempty,string" tfsdk:"close"`
+	CloseWait   *int `json:"close-wait,omitempty,string" tfsdk:"close_wait"`
+	Established *int `json:"established,omitempty,string" tfsdk:"established"`
+	FinWait     *int `json:"fin-wait,omitempty,string" tfsdk:"fin_wait"`
+	LastAck     *int `json:"last-ack,omitempty,string" tfsdk:"last_ack"`
+	SynRecv     *int `json:"syn-recv,omitempty,string" tfsdk:"syn_recv"`
+	SynSent     *int `json:"syn-sent,omitempty,string" tfsdk:"syn_sent"`
+	TimeWait    *int `json:"time-wait,omitempty,string" tfsdk:"time_wait"`
+}
+
+type ConntrackUDPTimeouts struct {
+	Other  *int `json:"other,omitempty,string" tfsdk:"other"`
+	Stream *int `json:"stream,omitempty,string" tfsdk:"stream"`
+}
+
+type ConntrackTimeouts struct {
+	ICMP  *int                  `json:"icmp,omitempty,string" tfsdk:"icmp"`
+	Other *int                  `json:"other,omitempty,string" tfsdk:"other"`
+	TCP   *ConntrackTCPTimeouts `json:"tcp,omitempty" tfsdk:"tcp"`
+	UDP   *ConntrackUDPTimeouts `json:"udp,omitempty" tfsdk:"udp"`
+}
+
+// ConntrackModules turns the connection tracking helpers on or off. The
+// helpers are on unless they are explicitly disabled.
+type ConntrackModules struct {
+	FTP  *bool `tfsdk:"ftp"`
+	GRE  *bool `tfsdk:"gre"`
+	H323 *bool `tfsdk:"h323"`
+	PPTP *bool `tfsdk:"pptp"`
+	SIP  *bool `tfsdk:"sip"`
+	TFTP *bool `tfsdk:"tftp"`
+}
+
+type Conntrack struct {
+	ID              tftypes.String     `json:"-" tfsdk:"id"`
+	TableSize       *int               `json:"table-size,omitempty,string" tfsdk:"table_size"`
+	ExpectTableSize *int               `json:"expect-table-size,omitempty,string" tfsdk:"expect_table_size"`
+	HashSize        *int               `json:"hash-size,omitempty,string" tfsdk:"hash_size"`
+	Timeouts        *ConntrackTimeouts `json:"timeout,omitempty" tfsdk:"timeouts"`
+	Modules         *ConntrackModules  `json:"-" tfsdk:"modules"`
+}
+
+func (c *Conntrack) GetID() string {
+	return ConntrackID
+}
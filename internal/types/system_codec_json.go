@@ -0,0 +1,201 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type apiUserAuthentication struct {
+	EncryptedPassword *string `json:"encrypted-password,omitempty"`
+	PlaintextPassword *string `json:"plaintext-password,omitempty"`
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	var auth *apiUserAuthentication
+	if u.Password != nil || u.EncryptedPassword != nil {
+		auth = &apiUserAuthentication{
+			EncryptedPassword: u.EncryptedPassword,
+			PlaintextPassword: u.Password,
+		}
+	}
+
+	type Alias User
+	return json.Marshal(&struct {
+		Authentication *apiUserAuthentication `json:"authentication,omitempty"`
+		*Alias
+	}{
+		Authentication: auth,
+		Alias:          (*Alias)(&u),
+	})
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type Alias User
+	aux := &struct {
+		Authentication apiUserAuthentication `json:"authentication"`
+		*Alias
+	}{
+		Alias: (*Alias)(u),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("user", data, err)
+	}
+
+	// The router hashes a plaintext password as soon as it is committed and
+	// leaves an empty plaintext-password node behind.
+	u.Password = nil
+	if p := aux.Authentication.PlaintextPassword; p != nil && *p != "" {
+		u.Password = p
+	}
+	u.EncryptedPassword = aux.Authentication.EncryptedPassword
+	return nil
+}
+
+type apiNTP struct {
+	Server map[string]*flag `json:"server,omitempty"`
+}
+
+func (s System) MarshalJSON() ([]byte, error) {
+	var ntp *apiNTP
+	if len(s.NTPServers) > 0 {
+		ntp = &apiNTP{Server: map[string]*flag{}}
+		for _, server := range s.NTPServers {
+			ntp.Server[server] = &flag{present: true}
+		}
+	}
+
+	type Alias System
+	return json.Marshal(&struct {
+		NTP *apiNTP `json:"ntp,omitempty"`
+		*Alias
+	}{
+		NTP:   ntp,
+		Alias: (*Alias)(&s),
+	})
+}
+
+func (s *System) UnmarshalJSON(data []byte) error {
+	type Alias System
+	aux := &struct {
+		NTP struct {
+			Server map[string]json.RawMessage `json:"server"`
+		} `json:"ntp"`
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("system", data, err)
+	}
+
+	s.NTPServers = nil
+	for server := range aux.NTP.Server {
+		s.NTPServers = append(s.NTPServers, server)
+	}
+	sortedKeys(s.NTPServers)
+	return nil
+}
+
+type apiSyslogFacility struct {
+	Level string `json:"level"`
+}
+
+func (h SyslogHost) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Facility map[string]*apiSyslogFacility `json:"facility"`
+	}{
+		Facility: map[string]*apiSyslogFacility{
+			h.Facility: {Level: h.Level},
+		},
+	})
+}
+
+func (h *SyslogHost) UnmarshalJSON(data []byte) error {
+	aux := &struct {
+		Facility map[string]*apiSyslogFacility `json:"facility"`
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("syslog host", data, err)
+	}
+
+	// Only a single facility is managed per host. Should the router report
+	// more, the first one in alphabetical order is used.
+	keys := []string{}
+	for k := range aux.Facility {
+		keys = append(keys, k)
+	}
+	h.Facility, h.Level = "", ""
+	if len(keys) > 0 {
+		h.Facility = sortedKeys(keys)[0]
+		if f := aux.Facility[h.Facility]; f != nil {
+			h.Level = f.Level
+		}
+	}
+	return nil
+}
+
+type apiConntrackModule struct {
+	Disable *flag `json:"disable,omitempty"`
+}
+
+// modules maps the name of each helper to its field.
+func (m *ConntrackModules) modules() map[string]**bool {
+	return map[string]**bool{
+		"ftp":  &m.FTP,
+		"gre":  &m.GRE,
+		"h323": &m.H323,
+		"pptp": &m.PPTP,
+		"sip":  &m.SIP,
+		"tftp": &m.TFTP,
+	}
+}
+
+func (c Conntrack) MarshalJSON() ([]byte, error) {
+	// Only disabled helpers have a node. Enabling a helper removes its node.
+	var modules map[string]*apiConntrackModule
+	if c.Modules != nil {
+		for name, enabled := range c.Modules.modules() {
+			if *enabled == nil || **enabled {
+				continue
+			}
+			if modules == nil {
+				modules = map[string]*apiConntrackModule{}
+			}
+			modules[name] = &apiConntrackModule{Disable: &flag{present: true}}
+		}
+	}
+
+	type Alias Conntrack
+	return json.Marshal(&struct {
+		Modules map[string]*apiConntrackModule `json:"modules,omitempty"`
+		*Alias
+	}{
+		Modules: modules,
+		Alias:   (*Alias)(&c),
+	})
+}
+
+func (c *Conntrack) UnmarshalJSON(data []byte) error {
+	type Alias Conntrack
+	aux := &struct {
+		Modules map[string]struct {
+			Disable flag `json:"disable"`
+		} `json:"modules"`
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("conntrack", data, err)
+	}
+
+	c.Modules = nil
+	if len(aux.Modules) > 0 {
+		c.Modules = &ConntrackModules{}
+		for name, enabled := range c.Modules.modules() {
+			if module, ok := aux.Modules[name]; ok {
+				*enabled = boolptr(!module.Disable.present)
+			}
+		}
+	}
+	return nil
+}
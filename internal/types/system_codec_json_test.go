@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestConntrackCodec(t *testing.T) {
+	size, established := 262144, 7440
+	expected := Conntrack{
+		TableSize: &size,
+		Timeouts: &ConntrackTimeouts{
+			TCP: &ConntrackTCPTimeouts{
+				Established: &established,
+			},
+		},
+		Modules: &ConntrackModules{
+			SIP: boolptr(false),
+		},
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"modules":{"sip":{"disable":null}},"table-size":"262144","timeout":{"tcp":{"established":"7440"}}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual Conntrack
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+
+	// Enabled helpers have no node.
+	expected.Modules.SIP = boolptr(true)
+	if data, err = json.Marshal(expected); err != nil {
+		t.Fatal(err)
+	}
+
+	const enabled = `{"table-size":"262144","timeout":{"tcp":{"established":"7440"}}}`
+	if string(data) != enabled {
+		t.Fatalf("expected %s, got %s", enabled, string(data))
+	}
+}
+
+func TestSyslogHostCodec(t *testing.T) {
+	expected := SyslogHost{
+		Facility: "all",
+		Level:    "notice",
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"facility":{"all":{"level":"notice"}}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual SyslogHost
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+
+	if err := json.Unmarshal([]byte(`{"facility":{"kern":{"level":"err"},"daemon":{"level":"debug"}}}`), &actual); err != nil {
+		t.Fatal(err)
+	}
+	if actual.Facility != "daemon" || actual.Level != "debug" {
+		t.Errorf("expected the first facility in alphabetical order, got %+v", actual)
+	}
+}
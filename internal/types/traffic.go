@@ -0,0 +1,69 @@
+package types
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SmartQueueDirection struct {
+	Rate       string  `json:"rate" tfsdk:"rate"`
+	Burst      *string `json:"burst,omitempty" tfsdk:"burst"`
+	ECN        *Toggle `json:"ecn,omitempty" tfsdk:"ecn"`
+	Flows      *int    `json:"flows,omitempty,string" tfsdk:"flows"`
+	FQQuantum  *int    `json:"fq-quantum,omitempty,string" tfsdk:"fq_quantum"`
+	HTBQuantum *int    `json:"htb-quantum,omitempty,string" tfsdk:"htb_quantum"`
+	Interval   *string `json:"interval,omitempty" tfsdk:"interval"`
+	Limit      *int    `json:"limit,omitempty,string" tfsdk:"limit"`
+	Target     *string `json:"target,omitempty" tfsdk:"target"`
+}
+
+type SmartQueue struct {
+	ID           tftypes.String       `json:"-" tfsdk:"id"`
+	Name         string               `json:"-" tfsdk:"name"`
+	WANInterface string               `json:"wan-interface" tfsdk:"wan_interface"`
+	Download     *SmartQueueDirection `json:"download,omitempty" tfsdk:"download"`
+	Upload       *SmartQueueDirection `json:"upload,omitempty" tfsdk:"upload"`
+}
+
+func (q *SmartQueue) GetID() string {
+	return q.Name
+}
+
+// ShaperMatch describes the traffic that belongs to a shaper class. The
+// criteria are the same as the ones of a firewall rule.
+type ShaperMatch struct {
+	Description *string      `json:"description,omitempty" tfsdk:"description"`
+	Protocol    *string      `json:"-" tfsdk:"protocol"`
+	Source      *Source      `json:"-" tfsdk:"source"`
+	Destination *Destination `json:"-" tfsdk:"destination"`
+}
+
+type ShaperDefault struct {
+	Bandwidth string  `json:"bandwidth" tfsdk:"bandwidth"`
+	Ceiling   *string `json:"ceiling,omitempty" tfsdk:"ceiling"`
+	Burst     *string `json:"burst,omitempty" tfsdk:"burst"`
+	Priority  *int    `json:"priority,omitempty,string" tfsdk:"priority"`
+	QueueType *string `json:"queue-type,omitempty" tfsdk:"queue_type"`
+}
+
+type ShaperClass struct {
+	Description *string                 `json:"description,omitempty" tfsdk:"description"`
+	Bandwidth   string                  `json:"bandwidth" tfsdk:"bandwidth"`
+	Ceiling     *string                 `json:"ceiling,omitempty" tfsdk:"ceiling"`
+	Burst       *string                 `json:"burst,omitempty" tfsdk:"burst"`
+	Priority    *int                    `json:"priority,omitempty,string" tfsdk:"priority"`
+	QueueType   *string                 `json:"queue-type,omitempty" tfsdk:"queue_type"`
+	Match       map[string]*ShaperMatch `json:"match,omitempty" tfsdk:"match"`
+}
+
+type Shaper struct {
+	ID          tftypes.String          `json:"-" tfsdk:"id"`
+	Name        string                  `json:"-" tfsdk:"name"`
+	Description *string                 `json:"description,omitempty" tfsdk:"description"`
+	Bandwidth   string                  `json:"bandwidth" tfsdk:"bandwidth"`
+	Default     *ShaperDefault          `json:"default,omitempty" tfsdk:"default"`
+	Classes     map[string]*ShaperClass `json:"class,omitempty" tfsdk:"classes"`
+}
+
+func (s *Shaper) GetID() string {
+	return s.Name
+}
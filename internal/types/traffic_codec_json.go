@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type apiShaperIP struct {
+	Protocol    *string      `json:"protocol,omitempty"`
+	Source      *Source      `json:"source,omitempty"`
+	Destination *Destination `json:"destination,omitempty"`
+}
+
+type apiShaperEther struct {
+	Source *string `json:"source,omitempty"`
+}
+
+func (m *ShaperMatch) MarshalJSON() ([]byte, error) {
+	// The mac address of the source is matched on the ethernet header rather
+	// than on the ip header.
+	var source *Source
+	var ether *apiShaperEther
+	if m.Source != nil {
+		if m.Source.MAC != nil {
+			ether = &apiShaperEther{Source: m.Source.MAC}
+		}
+		if s := (Source{
+			Address:      m.Source.Address,
+			AddressGroup: m.Source.AddressGroup,
+			PortGroup:    m.Source.PortGroup,
+			Port:         m.Source.Port,
+		}); s != (Source{}) {
+			source = &s
+		}
+	}
+
+	var ip *apiShaperIP
+	if m.Protocol != nil || source != nil || m.Destination != nil {
+		ip = &apiShaperIP{
+			Protocol:    m.Protocol,
+			Source:      source,
+			Destination: m.Destination,
+		}
+	}
+
+	type Alias ShaperMatch
+	return json.Marshal(&struct {
+		IP    *apiShaperIP    `json:"ip,omitempty"`
+		Ether *apiShaperEther `json:"ether,omitempty"`
+		*Alias
+	}{
+		IP:    ip,
+		Ether: ether,
+		Alias: (*Alias)(m),
+	})
+}
+
+func (m *ShaperMatch) UnmarshalJSON(data []byte) error {
+	type Alias ShaperMatch
+	aux := &struct {
+		IP    apiShaperIP    `json:"ip"`
+		Ether apiShaperEther `json:"ether"`
+		*Alias
+	}{
+		Alias: (*Alias)(m),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("shaper match", data, err)
+	}
+
+	m.Protocol = aux.IP.Protocol
+	m.Source = aux.IP.Source
+	m.Destination = aux.IP.Destination
+	if aux.Ether.Source != nil {
+		if m.Source == nil {
+			m.Source = &Source{}
+		}
+		m.Source.MAC = aux.Ether.Source
+	}
+	return nil
+}
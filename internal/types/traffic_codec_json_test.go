@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestShaperMatchCodec(t *testing.T) {
+	protocol, address, mac, group := "udp", "192.168.1.10/32", "00:11:22:33:44:55", "voip"
+	expected := ShaperMatch{
+		Protocol: &protocol,
+		Source: &Source{
+			Address: &address,
+			MAC:     &mac,
+		},
+		Destination: &Destination{
+			PortGroup: &group,
+		},
+	}
+
+	data, err := json.Marshal(&expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"ip":{"protocol":"udp","source":{"address":"192.168.1.10/32"},"destination":{"group":{"port-group":"voip"}}},"ether":{"source":"00:11:22:33:44:55"}}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual ShaperMatch
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DeadPeerDetection struct {
+	Action   string `json:"action" tfsdk:"action"`
+	Interval *int   `json:"interval,omitempty,string" tfsdk:"interval"`
+	Timeout  *int   `json:"timeout,omitempty,string" tfsdk:"timeout"`
+}
+
+type IKEProposal struct {
+	Encryption string `json:"encryption" tfsdk:"encryption"`
+	Hash       string `json:"hash" tfsdk:"hash"`
+	DHGroup    *int   `json:"dh-group,omitempty,string" tfsdk:"dh_group"`
+}
+
+type IKEGroup struct {
+	ID                tftypes.String     `json:"-" tfsdk:"id"`
+	Name              string             `json:"-" tfsdk:"name"`
+	KeyExchange       *string            `json:"key-exchange,omitempty" tfsdk:"key_exchange"`
+	Lifetime          *int               `json:"lifetime,omitempty,string" tfsdk:"lifetime"`
+	DeadPeerDetection *DeadPeerDetection `json:"dead-peer-detection,omitempty" tfsdk:"dead_peer_detection"`
+	Proposals         []*IKEProposal     `json:"-" tfsdk:"proposal"` // Omitting the json tag due to custom marshal/unmarshal methods.
+}
+
+type ESPProposal struct {
+	Encryption string `json:"encryption" tfsdk:"encryption"`
+	Hash       string `json:"hash" tfsdk:"hash"`
+}
+
+type ESPGroup struct {
+	ID          tftypes.String `json:"-" tfsdk:"id"`
+	Name        string         `json:"-" tfsdk:"name"`
+	Compression *Toggle        `json:"compression,omitempty" tfsdk:"compression"`
+	Lifetime    *int           `json:"lifetime,omitempty,string" tfsdk:"lifetime"`
+	Mode        *string        `json:"mode,omitempty" tfsdk:"mode"`
+	PFS         *string        `json:"pfs,omitempty" tfsdk:"pfs"`
+	Proposals   []*ESPProposal `json:"-" tfsdk:"proposal"` // Omitting the json tag due to custom marshal/unmarshal methods.
+}
+
+type Tunnel struct {
+	ESPGroup     *string `json:"esp-group,omitempty" tfsdk:"esp_group"`
+	LocalPrefix  string  `json:"-" tfsdk:"local_prefix"`
+	RemotePrefix string  `json:"-" tfsdk:"remote_prefix"`
+	Protocol     *string `json:"protocol,omitempty" tfsdk:"protocol"`
+}
+
+// PeerFirewall describes where the firewall rules that allow IKE, NAT-T and
+// ESP traffic from a peer are placed. The rules are not part of the peer's
+// configuration node.
+type PeerFirewall struct {
+	Ruleset  string `tfsdk:"ruleset"`
+	Priority int    `tfsdk:"priority"`
+}
+
+type SiteToSitePeer struct {
+	ID              tftypes.String     `json:"-" tfsdk:"id"`
+	Peer            string             `json:"-" tfsdk:"peer"`
+	Description     *string            `json:"description,omitempty" tfsdk:"description"`
+	PreSharedSecret string             `json:"-" tfsdk:"pre_shared_secret"`
+	LocalAddress    string             `json:"local-address" tfsdk:"local_address"`
+	ConnectionType  *string            `json:"connection-type,omitempty" tfsdk:"connection_type"`
+	IKEGroup        string             `json:"ike-group" tfsdk:"ike_group"`
+	DefaultESPGroup *string            `json:"default-esp-group,omitempty" tfsdk:"default_esp_group"`
+	Tunnels         map[string]*Tunnel `json:"tunnel,omitempty" tfsdk:"tunnel"`
+	LocalFirewall   *PeerFirewall      `json:"-" tfsdk:"local_firewall"`
+}
+
+func (g *IKEGroup) GetID() string {
+	return g.Name
+}
+
+func (g *ESPGroup) GetID() string {
+	return g.Name
+}
+
+func (p *SiteToSitePeer) GetID() string {
+	return p.Peer
+}
+
+// L2TPRemoteAccessID is the identifier of the L2TP remote access server. There
+// is only one per router.
+const L2TPRemoteAccessID = "l2tp-remote-access"
+
+type ClientIPPool struct {
+	Start string `json:"start" tfsdk:"start"`
+	Stop  string `json:"stop" tfsdk:"stop"`
+}
+
+type L2TPLocalUser struct {
+	Password string  `json:"password" tfsdk:"password"`
+	StaticIP *string `json:"static-ip,omitempty" tfsdk:"static_ip"`
+}
+
+type RadiusServer struct {
+	Key string `json:"key" tfsdk:"key"`
+}
+
+type L2TPRemoteAccess struct {
+	ID              tftypes.String            `json:"-" tfsdk:"id"`
+	OutsideAddress  string                    `json:"outside-address" tfsdk:"outside_address"`
+	ClientIPPool    *ClientIPPool             `json:"client-ip-pool,omitempty" tfsdk:"client_ip_pool"`
+	DNSServers      []string                  `json:"-" tfsdk:"dns_servers"`
+	PreSharedSecret string                    `json:"-" tfsdk:"pre_shared_secret"`
+	LocalUsers      map[string]*L2TPLocalUser `json:"-" tfsdk:"local_users"`
+	RadiusServers   map[string]*RadiusServer  `json:"-" tfsdk:"radius_servers"`
+	MTU             *int                      `json:"mtu,omitempty,string" tfsdk:"mtu"`
+}
+
+func (l *L2TPRemoteAccess) GetID() string {
+	return L2TPRemoteAccessID
+}
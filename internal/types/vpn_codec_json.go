@@ -0,0 +1,246 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type apiPrefix struct {
+	Prefix string `json:"prefix,omitempty"`
+}
+
+type apiAuthentication struct {
+	Mode            string `json:"mode,omitempty"`
+	PreSharedSecret string `json:"pre-shared-secret,omitempty"`
+}
+
+func (g IKEGroup) MarshalJSON() ([]byte, error) {
+	proposals := map[string]*IKEProposal{}
+	for i, p := range g.Proposals {
+		proposals[index(i)] = p
+	}
+
+	type Alias IKEGroup
+	return json.Marshal(&struct {
+		Proposals map[string]*IKEProposal `json:"proposal,omitempty"`
+		*Alias
+	}{
+		Proposals: proposals,
+		Alias:     (*Alias)(&g),
+	})
+}
+
+func (g *IKEGroup) UnmarshalJSON(data []byte) error {
+	type Alias IKEGroup
+	aux := &struct {
+		Proposals map[string]*IKEProposal `json:"proposal,omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(g),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("ike group", data, err)
+	}
+
+	g.Proposals = nil
+	keys := []string{}
+	for k := range aux.Proposals {
+		keys = append(keys, k)
+	}
+	for _, k := range sortedKeys(keys) {
+		g.Proposals = append(g.Proposals, aux.Proposals[k])
+	}
+	return nil
+}
+
+func (g ESPGroup) MarshalJSON() ([]byte, error) {
+	proposals := map[string]*ESPProposal{}
+	for i, p := range g.Proposals {
+		proposals[index(i)] = p
+	}
+
+	type Alias ESPGroup
+	return json.Marshal(&struct {
+		Proposals map[string]*ESPProposal `json:"proposal,omitempty"`
+		*Alias
+	}{
+		Proposals: proposals,
+		Alias:     (*Alias)(&g),
+	})
+}
+
+func (g *ESPGroup) UnmarshalJSON(data []byte) error {
+	type Alias ESPGroup
+	aux := &struct {
+		Proposals map[string]*ESPProposal `json:"proposal,omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(g),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("esp group", data, err)
+	}
+
+	g.Proposals = nil
+	keys := []string{}
+	for k := range aux.Proposals {
+		keys = append(keys, k)
+	}
+	for _, k := range sortedKeys(keys) {
+		g.Proposals = append(g.Proposals, aux.Proposals[k])
+	}
+	return nil
+}
+
+func (t *Tunnel) MarshalJSON() ([]byte, error) {
+	type Alias Tunnel
+	return json.Marshal(&struct {
+		Local  *apiPrefix `json:"local,omitempty"`
+		Remote *apiPrefix `json:"remote,omitempty"`
+		*Alias
+	}{
+		Local:  &apiPrefix{Prefix: t.LocalPrefix},
+		Remote: &apiPrefix{Prefix: t.RemotePrefix},
+		Alias:  (*Alias)(t),
+	})
+}
+
+func (t *Tunnel) UnmarshalJSON(data []byte) error {
+	type Alias Tunnel
+	aux := &struct {
+		Local  apiPrefix `json:"local"`
+		Remote apiPrefix `json:"remote"`
+		*Alias
+	}{
+		Alias: (*Alias)(t),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("tunnel", data, err)
+	}
+
+	t.LocalPrefix = aux.Local.Prefix
+	t.RemotePrefix = aux.Remote.Prefix
+	return nil
+}
+
+func (p SiteToSitePeer) MarshalJSON() ([]byte, error) {
+	type Alias SiteToSitePeer
+	return json.Marshal(&struct {
+		Authentication *apiAuthentication `json:"authentication,omitempty"`
+		*Alias
+	}{
+		Authentication: &apiAuthentication{
+			Mode:            "pre-shared-secret",
+			PreSharedSecret: p.PreSharedSecret,
+		},
+		Alias: (*Alias)(&p),
+	})
+}
+
+func (p *SiteToSitePeer) UnmarshalJSON(data []byte) error {
+	type Alias SiteToSitePeer
+	aux := &struct {
+		Authentication apiAuthentication `json:"authentication"`
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("site-to-site peer", data, err)
+	}
+
+	p.PreSharedSecret = aux.Authentication.PreSharedSecret
+	return nil
+}
+
+type apiDNSServers struct {
+	Server1 string `json:"server-1,omitempty"`
+	Server2 string `json:"server-2,omitempty"`
+}
+
+type apiL2TPAuthentication struct {
+	Mode         string                   `json:"mode,omitempty"`
+	LocalUsers   *apiL2TPLocalUsers       `json:"local-users,omitempty"`
+	RadiusServer map[string]*RadiusServer `json:"radius-server,omitempty"`
+}
+
+type apiL2TPLocalUsers struct {
+	Username map[string]*L2TPLocalUser `json:"username,omitempty"`
+}
+
+type apiIPsecSettings struct {
+	Authentication *apiAuthentication `json:"authentication,omitempty"`
+}
+
+func (l L2TPRemoteAccess) MarshalJSON() ([]byte, error) {
+	var dns *apiDNSServers
+	if len(l.DNSServers) > 0 {
+		dns = &apiDNSServers{Server1: l.DNSServers[0]}
+		if len(l.DNSServers) > 1 {
+			dns.Server2 = l.DNSServers[1]
+		}
+	}
+
+	auth := &apiL2TPAuthentication{Mode: "local"}
+	if len(l.RadiusServers) > 0 {
+		auth.Mode = "radius"
+		auth.RadiusServer = l.RadiusServers
+	}
+	if len(l.LocalUsers) > 0 {
+		auth.LocalUsers = &apiL2TPLocalUsers{Username: l.LocalUsers}
+	}
+
+	type Alias L2TPRemoteAccess
+	return json.Marshal(&struct {
+		Authentication *apiL2TPAuthentication `json:"authentication,omitempty"`
+		DNSServers     *apiDNSServers         `json:"dns-servers,omitempty"`
+		IPsecSettings  *apiIPsecSettings      `json:"ipsec-settings,omitempty"`
+		*Alias
+	}{
+		Authentication: auth,
+		DNSServers:     dns,
+		IPsecSettings: &apiIPsecSettings{
+			Authentication: &apiAuthentication{
+				Mode:            "pre-shared-secret",
+				PreSharedSecret: l.PreSharedSecret,
+			},
+		},
+		Alias: (*Alias)(&l),
+	})
+}
+
+func (l *L2TPRemoteAccess) UnmarshalJSON(data []byte) error {
+	type Alias L2TPRemoteAccess
+	aux := &struct {
+		Authentication apiL2TPAuthentication `json:"authentication"`
+		DNSServers     apiDNSServers         `json:"dns-servers"`
+		IPsecSettings  struct {
+			Authentication apiAuthentication `json:"authentication"`
+		} `json:"ipsec-settings"`
+		*Alias
+	}{
+		Alias: (*Alias)(l),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return malformed("l2tp remote access", data, err)
+	}
+
+	l.DNSServers = nil
+	for _, server := range []string{aux.DNSServers.Server1, aux.DNSServers.Server2} {
+		if server != "" {
+			l.DNSServers = append(l.DNSServers, server)
+		}
+	}
+
+	l.LocalUsers = nil
+	if aux.Authentication.LocalUsers != nil && len(aux.Authentication.LocalUsers.Username) > 0 {
+		l.LocalUsers = aux.Authentication.LocalUsers.Username
+	}
+
+	l.RadiusServers = nil
+	if len(aux.Authentication.RadiusServer) > 0 {
+		l.RadiusServers = aux.Authentication.RadiusServer
+	}
+
+	l.PreSharedSecret = aux.IPsecSettings.Authentication.PreSharedSecret
+	return nil
+}
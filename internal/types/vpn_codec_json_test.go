@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestL2TPRemoteAccessCodec(t *testing.T) {
+	mtu := 1492
+	expected := L2TPRemoteAccess{
+		OutsideAddress:  "203.0.113.1",
+		DNSServers:      []string{"192.168.1.1", "192.168.1.2"},
+		PreSharedSecret: "secret",
+		LocalUsers: map[string]*L2TPLocalUser{
+			"alice": {Password: "password"},
+		},
+		MTU: &mtu,
+	}
+
+	data, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const tree = `{"authentication":{"mode":"local","local-users":{"username":{"alice":{"password":"password"}}}},"dns-servers":{"server-1":"192.168.1.1","server-2":"192.168.1.2"},"ipsec-settings":{"authentication":{"mode":"pre-shared-secret","pre-shared-secret":"secret"}},"outside-address":"203.0.113.1","mtu":"1492"}`
+	if string(data) != tree {
+		t.Fatalf("expected %s, got %s", tree, string(data))
+	}
+
+	var actual L2TPRemoteAccess
+	if err := json.Unmarshal(data, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
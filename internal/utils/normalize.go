@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// Normalize walks desired and actual in lockstep and, wherever desired holds
+// an explicit false and actual holds null or false, copies desired into actual.
+//
+// EdgeOS does not differentiate between a disabled and an unset boolean. If we
+// don't paper over this, terraform fails with errors of the following type:
+//
+//	provider produced an unexpected new value: .compression: was cty.False, but now null.
+//
+// Both arguments must be pointers to values of the same type.
+func Normalize(desired, actual interface{}) {
+	d, a := reflect.ValueOf(desired), reflect.ValueOf(actual)
+	if d.Kind() != reflect.Ptr || a.Kind() != reflect.Ptr || d.Type() != a.Type() {
+		return
+	}
+	normalize(d, a)
+}
+
+func normalize(d, a reflect.Value) {
+	switch a.Kind() {
+	case reflect.Ptr:
+		if d.IsNil() {
+			return
+		}
+		if a.Type().Elem().Kind() == reflect.Bool {
+			if !d.Elem().Bool() && (a.IsNil() || !a.Elem().Bool()) && a.CanSet() {
+				a.Set(d)
+			}
+			return
+		}
+		if a.IsNil() {
+			return
+		}
+		normalize(d.Elem(), a.Elem())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if a.Field(i).CanSet() {
+				normalize(d.Field(i), a.Field(i))
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < a.Len() && i < d.Len(); i++ {
+			normalize(d.Index(i), a.Index(i))
+		}
+	case reflect.Map:
+		if a.Type().Elem().Kind() != reflect.Ptr {
+			return
+		}
+		for _, k := range a.MapKeys() {
+			if dv := d.MapIndex(k); dv.IsValid() {
+				normalize(dv, a.MapIndex(k))
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+type normalizeInner struct {
+	Enabled *bool `json:"enabled"`
+}
+
+type normalizeOuter struct {
+	Name     string                     `json:"name"`
+	Disabled *bool                      `json:"disabled"`
+	Inner    *normalizeInner            `json:"inner"`
+	List     []normalizeInner           `json:"list"`
+	Map      map[string]*normalizeInner `json:"map"`
+}
+
+func TestNormalize(t *testing.T) {
+	f, tr := false, true
+
+	for name, test := range map[string]struct {
+		desired  normalizeOuter
+		actual   normalizeOuter
+		expected normalizeOuter
+	}{
+		"explicit false is restored": {
+			desired:  normalizeOuter{Disabled: &f},
+			actual:   normalizeOuter{},
+			expected: normalizeOuter{Disabled: &f},
+		},
+		"true is not hidden": {
+			desired:  normalizeOuter{Disabled: &f},
+			actual:   normalizeOuter{Disabled: &tr},
+			expected: normalizeOuter{Disabled: &tr},
+		},
+		"null stays null": {
+			desired:  normalizeOuter{},
+			actual:   normalizeOuter{},
+			expected: normalizeOuter{},
+		},
+		"nested values": {
+			desired: normalizeOuter{
+				Inner: &normalizeInner{Enabled: &f},
+				List:  []normalizeInner{{Enabled: &f}, {Enabled: &f}},
+				Map:   map[string]*normalizeInner{"a": {Enabled: &f}},
+			},
+			actual: normalizeOuter{
+				Inner: &normalizeInner{},
+				List:  []normalizeInner{{}},
+				Map:   map[string]*normalizeInner{"a": {}, "b": {}},
+			},
+			expected: normalizeOuter{
+				Inner: &normalizeInner{Enabled: &f},
+				List:  []normalizeInner{{Enabled: &f}},
+				Map:   map[string]*normalizeInner{"a": {Enabled: &f}, "b": {}},
+			},
+		},
+		"missing nested values are not created": {
+			desired:  normalizeOuter{Inner: &normalizeInner{Enabled: &f}},
+			actual:   normalizeOuter{},
+			expected: normalizeOuter{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			Normalize(&test.desired, &test.actual)
+			if !reflect.DeepEqual(test.actual, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, test.actual)
+			}
+		})
+	}
+}
+
+func TestNormalizeMismatchedTypes(t *testing.T) {
+	f := false
+	desired, actual := normalizeOuter{Disabled: &f}, normalizeInner{}
+
+	Normalize(&desired, &actual)
+	Normalize(desired, actual)
+
+	if actual.Enabled != nil {
+		t.Errorf("expected mismatched types to be left alone, got %+v", actual)
+	}
+}
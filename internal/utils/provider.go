@@ -19,6 +19,20 @@ type api interface {
 	Delete(context.Context, string) error
 }
 
+// normalizer is implemented by an api that needs to reconcile what the router
+// returns with what terraform last knew about the resource. This is required
+// for values the router never reports back, such as plaintext passwords.
+type normalizer interface {
+	Normalize(known, actual interface{}) interface{}
+}
+
+// finalizer is implemented by an api that needs the last known state of a
+// resource in order to delete it, e.g. because it manages configuration that
+// lives outside of the resource's own node.
+type finalizer interface {
+	Finalize(ctx context.Context, known interface{}) error
+}
+
 type Resource struct {
 	Type         interface{}
 	Name         string
@@ -40,6 +54,23 @@ func (r Resource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, r
 }
 
 func (r Resource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	read := r.Api.Read
+
+	if n, ok := r.Api.(normalizer); ok {
+		read = func(ctx context.Context, id string) (interface{}, error) {
+			actual, err := r.Api.Read(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			known, diags := retrieve(ctx, req.State, r.Type)
+			if diags.HasError() {
+				return actual, nil
+			}
+			return n.Normalize(known, actual), nil
+		}
+	}
+
 	ReadFunc(
 		ctx,
 		req,
@@ -47,7 +78,7 @@ func (r Resource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp
 		r.Attribute,
 		r.Name,
 		r.IsConfigured,
-		r.Api.Read,
+		read,
 	)
 }
 
@@ -63,6 +94,18 @@ func (r Resource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, r
 }
 
 func (r Resource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	del := r.Api.Delete
+
+	if f, ok := r.Api.(finalizer); ok {
+		del = func(ctx context.Context, id string) error {
+			known, diags := retrieve(ctx, req.State, r.Type)
+			if diags.HasError() {
+				return fmt.Errorf("Could not retrieve the %s from state.", r.Name)
+			}
+			return f.Finalize(ctx, known)
+		}
+	}
+
 	DeleteFunc(
 		ctx,
 		req,
@@ -70,7 +113,7 @@ func (r Resource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, r
 		r.Attribute,
 		r.Name,
 		r.IsConfigured,
-		r.Api.Delete,
+		del,
 	)
 }
 
@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mattbaird/jsonpatch"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type hookResource struct {
+	ID     string  `tfsdk:"id"`
+	Secret *string `tfsdk:"secret"`
+}
+
+// hookApi never returns the secret, like the router does for passwords.
+type hookApi struct {
+	deleted *string
+}
+
+func (a hookApi) Read(_ context.Context, id string) (interface{}, error) {
+	return &hookResource{ID: id}, nil
+}
+
+func (a hookApi) Create(_ context.Context, plan interface{}) (interface{}, error) {
+	return plan, nil
+}
+
+func (a hookApi) Update(_ context.Context, _, desired interface{}, _ []jsonpatch.JsonPatchOperation) (interface{}, error) {
+	return desired, nil
+}
+
+func (a hookApi) Delete(_ context.Context, id string) error {
+	*a.deleted = id
+	return nil
+}
+
+type hookApiWithHooks struct {
+	hookApi
+}
+
+func (a hookApiWithHooks) Normalize(known, actual interface{}) interface{} {
+	k, r := known.(hookResource), actual.(*hookResource)
+	r.Secret = k.Secret
+	return r
+}
+
+func (a hookApiWithHooks) Finalize(_ context.Context, known interface{}) error {
+	k := known.(hookResource)
+	*a.deleted = "finalized " + *k.Secret
+	return nil
+}
+
+func hookState() tfsdk.State {
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id":     {Type: types.StringType, Computed: true},
+			"secret": {Type: types.StringType, Optional: true},
+		},
+	}
+	return tfsdk.State{
+		Schema: schema,
+		Raw: tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, "foo"),
+			"secret": tftypes.NewValue(tftypes.String, "hunter2"),
+		}),
+	}
+}
+
+func TestResourceHooks(t *testing.T) {
+	ctx := context.Background()
+
+	for name, test := range map[string]struct {
+		api            api
+		expectedSecret *string
+		expectedDelete string
+	}{
+		"without hooks": {
+			api:            hookApi{deleted: new(string)},
+			expectedSecret: nil,
+			expectedDelete: "foo",
+		},
+		"with hooks": {
+			api:            hookApiWithHooks{hookApi{deleted: new(string)}},
+			expectedSecret: stringptr("hunter2"),
+			expectedDelete: "finalized hunter2",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := Resource{
+				Type:         hookResource{},
+				Name:         "hook",
+				IsConfigured: true,
+				Attribute:    "id",
+				Api:          test.api,
+			}
+
+			readResp := &tfsdk.ReadResourceResponse{State: hookState()}
+			r.Read(ctx, tfsdk.ReadResourceRequest{State: hookState()}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", readResp.Diagnostics)
+			}
+
+			var read hookResource
+			if diags := readResp.State.Get(ctx, &read); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if (read.Secret == nil) != (test.expectedSecret == nil) || (read.Secret != nil && *read.Secret != *test.expectedSecret) {
+				t.Errorf("expected secret %v, got %v", test.expectedSecret, read.Secret)
+			}
+
+			deleteResp := &tfsdk.DeleteResourceResponse{State: hookState()}
+			r.Delete(ctx, tfsdk.DeleteResourceRequest{State: hookState()}, deleteResp)
+			if deleteResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", deleteResp.Diagnostics)
+			}
+
+			var deleted string
+			switch a := test.api.(type) {
+			case hookApi:
+				deleted = *a.deleted
+			case hookApiWithHooks:
+				deleted = *a.deleted
+			}
+			if deleted != test.expectedDelete {
+				t.Errorf("expected %q, got %q", test.expectedDelete, deleted)
+			}
+		})
+	}
+}
+
+func stringptr(s string) *string {
+	return &s
+}
@@ -2,8 +2,7 @@ package utils
 
 import (
 	"context"
-
-	"github.com/frankgreco/edge-sdk-go/types"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
@@ -12,29 +11,14 @@ type terraformRetriever interface {
 	Get(context.Context, interface{}) diag.Diagnostics
 }
 
-// don't know how to reflect ... into interface {}
+// retrieve reflects the plan or state into a new value of the target's type.
 func retrieve(ctx context.Context, r terraformRetriever, target interface{}) (interface{}, diag.Diagnostics) {
-	switch target.(type) {
-	case types.AddressGroup:
-		var tmp types.AddressGroup
-		diags := r.Get(ctx, &tmp)
-		return tmp, diags
-	case types.PortGroup:
-		var tmp types.PortGroup
-		diags := r.Get(ctx, &tmp)
-		return tmp, diags
-	case types.FirewallAttachment:
-		var tmp types.FirewallAttachment
-		diags := r.Get(ctx, &tmp)
-		return tmp, diags
-	case types.Ruleset:
-		var tmp types.Ruleset
-		diags := r.Get(ctx, &tmp)
-		(&tmp).SetCodecMode(types.CodecModeLocal)
-		return tmp, diags
-	default:
+	if target == nil {
 		var diags diag.Diagnostics
 		diags.AddError("Could not unmarshal terraform plan", "Unknown go type")
 		return nil, diags
 	}
+	tmp := reflect.New(reflect.TypeOf(target))
+	diags := r.Get(ctx, tmp.Interface())
+	return tmp.Elem().Interface(), diags
 }